@@ -0,0 +1,16 @@
+# Read a sibling instance in the same environment.
+data "massdriver_instance" "database" {
+  id = "ecomm-prod-db"
+}
+
+locals {
+  db_params   = jsondecode(data.massdriver_instance.database.params)
+  db_hostname = one([for p in data.massdriver_instance.database.properties : p.value if p.path == ".database.hostname"])
+}
+
+# Without an ID, the data source reads the instance being deployed.
+data "massdriver_instance" "self" {}
+
+output "upgrade_available" {
+  value = data.massdriver_instance.self.available_upgrade != ""
+}
@@ -0,0 +1,21 @@
+# Read another instance's resource. Sensitive fields come back masked.
+data "massdriver_resource" "database" {
+  id = "9b3e1f7a-2c4d-4e8f-a1b6-5d7c9e0f2a3b"
+}
+
+# Export the unmasked payload. Every read is recorded in the audit log.
+data "massdriver_resource" "database_credentials" {
+  id     = "9b3e1f7a-2c4d-4e8f-a1b6-5d7c9e0f2a3b"
+  export = true
+}
+
+locals {
+  db = jsondecode(data.massdriver_resource.database_credentials.payload).data
+}
+
+# Render the resource in a format declared by its type.
+data "massdriver_resource" "database_env" {
+  id     = "9b3e1f7a-2c4d-4e8f-a1b6-5d7c9e0f2a3b"
+  export = true
+  format = "env"
+}
@@ -0,0 +1,15 @@
+data "massdriver_resource_type" "vpc" {
+  id = "aws-ec2-vpc"
+}
+
+# Warn when the bundle's local copy of the artifact schema no longer matches
+# the published resource type.
+check "vpc_schema_in_sync" {
+  assert {
+    condition = (
+      jsondecode(file("${path.module}/../schema-artifacts.json")).properties.vpc
+      == jsondecode(data.massdriver_resource_type.vpc.schema)
+    )
+    error_message = "schema-artifacts.json is out of date with the published aws-ec2-vpc resource type."
+  }
+}
@@ -0,0 +1,18 @@
+# Every imported VPC in the organization. Imported resources have no
+# environment, so they are matched by origin and type.
+data "massdriver_resources" "imported_vpcs" {
+  origin        = "IMPORTED"
+  resource_type = "aws-ec2-vpc"
+}
+
+resource "massdriver_environment_default" "vpc" {
+  for_each = { for r in data.massdriver_resources.imported_vpcs.resources : r.name => r }
+
+  environment_id = "ecomm-${each.key}"
+  resource_id    = each.value.id
+}
+
+# Every resource provisioned into an environment.
+data "massdriver_resources" "prod" {
+  environment_id = "ecomm-prod"
+}
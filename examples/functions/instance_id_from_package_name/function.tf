@@ -0,0 +1,5 @@
+# A package name carries a deployment suffix ("proj-env-db-0000"); the
+# instance ID is the name without it ("proj-env-db").
+data "massdriver_instance" "database" {
+  id = provider::massdriver::instance_id_from_package_name(var.database_package_name)
+}
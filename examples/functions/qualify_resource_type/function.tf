@@ -0,0 +1,7 @@
+# "aws-vpc@1.2.0" becomes "acme/aws-vpc"; "massdriver/aws-vpc" is unchanged.
+check "vpc_type" {
+  assert {
+    condition     = massdriver_resource.vpc.resource_type == provider::massdriver::qualify_resource_type("aws-vpc@1.2.0", "acme")
+    error_message = "The VPC was published with an unexpected resource type."
+  }
+}
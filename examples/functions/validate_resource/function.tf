@@ -0,0 +1,25 @@
+locals {
+  schemas  = jsondecode(file("${path.module}/../schema-artifacts.json"))
+  database = {
+    data = {
+      authentication = {
+        username = aws_db_instance.main.username
+        password = random_password.master.result
+      }
+    }
+  }
+}
+
+# Fail the plan early, with every violation listed, before any resource that
+# consumes the payload is created.
+resource "terraform_data" "database_payload" {
+  lifecycle {
+    precondition {
+      condition = provider::massdriver::validate_resource(
+        jsonencode(local.schemas.properties.database),
+        jsonencode(local.database),
+      )
+      error_message = "The database payload does not match its schema."
+    }
+  }
+}
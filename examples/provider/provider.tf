@@ -0,0 +1,19 @@
+# Inside a Massdriver bundle deployment no configuration is needed: the
+# provider picks up the deployment-scoped credentials from the environment.
+provider "massdriver" {}
+
+# Outside a deployment (CI pipelines, platform roots), configure credentials
+# explicitly or via MASSDRIVER_ORGANIZATION_ID / MASSDRIVER_API_KEY. Each
+# aliased block gets its own isolated client, so one root can target several
+# organizations at once.
+provider "massdriver" {
+  alias           = "shared"
+  organization_id = "acme-platform"
+  api_key         = var.platform_api_key
+}
+
+provider "massdriver" {
+  alias           = "tenant"
+  organization_id = "acme-tenant"
+  api_key         = var.tenant_api_key
+}
@@ -0,0 +1,3 @@
+# Artifacts are imported by ID from inside a bundle deployment (deployment
+# credentials are required).
+terraform import massdriver_artifact.vpc ecomm-prod-network-vpc
@@ -0,0 +1,2 @@
+# Components are imported by project ID and component identifier.
+terraform import massdriver_component.db ecomm/db
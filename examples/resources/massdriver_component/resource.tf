@@ -0,0 +1,19 @@
+resource "massdriver_component" "network" {
+  project_id = massdriver_project.ecomm.id
+  identifier = "net"
+  oci_repo   = "aws-vpc"
+  name       = "Network"
+}
+
+resource "massdriver_component" "db" {
+  project_id  = massdriver_project.ecomm.id
+  identifier  = "db"
+  oci_repo    = "aws-aurora-postgres"
+  name        = "Database"
+  description = "Orders database"
+
+  position {
+    x = 400
+    y = 120
+  }
+}
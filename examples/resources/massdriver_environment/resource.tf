@@ -0,0 +1,22 @@
+resource "massdriver_environment" "staging" {
+  identifier = "staging"
+  project_id = massdriver_project.ecomm.id
+  name       = "Staging"
+
+  attributes = {
+    tier = "silver"
+  }
+}
+
+# Fork production from staging, carrying over the environment default
+# resources (networks, clusters, credentials) as well as instance params.
+resource "massdriver_environment" "prod" {
+  identifier                = "prod"
+  fork_from                 = massdriver_environment.staging.id
+  copy_environment_defaults = true
+  name                      = "Production"
+}
+
+output "prod_monthly_cost" {
+  value = massdriver_environment.prod.cost[0].monthly_average
+}
@@ -0,0 +1,2 @@
+# Environment defaults are imported by environment ID and resource ID.
+terraform import massdriver_environment_default.prod_network ecomm-prod/8c1f6b52-0d7e-4a8b-9f3e-2a6d5c4b3e21
@@ -0,0 +1,6 @@
+# Every instance in production that needs an AWS VPC is connected to the
+# shared network automatically.
+resource "massdriver_environment_default" "prod_network" {
+  environment_id = massdriver_environment.prod.id
+  resource_id    = var.shared_vpc_resource_id
+}
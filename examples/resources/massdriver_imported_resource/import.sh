@@ -0,0 +1,4 @@
+# Imported resources are imported by resource ID. The payload cannot be read
+# back (sensitive fields are masked), so the next apply writes the configured
+# payload.
+terraform import massdriver_imported_resource.ci_role 2d7c4f0e-8a1b-4e5c-9f6d-3b2a1c0e9d8f
@@ -0,0 +1,12 @@
+# Register an IAM role that was created outside Massdriver so bundles can
+# consume it (e.g. as an environment default or remote reference).
+resource "massdriver_imported_resource" "ci_role" {
+  resource_type_id = "aws-iam-role"
+  name             = "CI role"
+
+  payload = jsonencode({
+    data = {
+      arn = aws_iam_role.ci.arn
+    }
+  })
+}
@@ -0,0 +1,4 @@
+# Links are imported by project ID, source component identifier and link ID.
+# Version constraints cannot be read back from Massdriver, so the configured
+# values are kept as-is after import.
+terraform import massdriver_link.db_network ecomm/net/2f0c9a4e-5d1b-4c7e-9a8f-1b2c3d4e5f60
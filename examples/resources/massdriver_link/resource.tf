@@ -0,0 +1,7 @@
+resource "massdriver_link" "db_network" {
+  from_component_id = massdriver_component.network.id
+  from_field        = "network"
+  from_version      = "~1.0"
+  to_component_id   = massdriver_component.db.id
+  to_field          = "network"
+}
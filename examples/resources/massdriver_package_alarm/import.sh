@@ -0,0 +1,3 @@
+# Package alarms are imported as <package_id>/<alarm_id>. Inside a deployment,
+# where MASSDRIVER_PACKAGE_NAME is set, the bare alarm ID is enough.
+terraform import massdriver_package_alarm.high_cpu ecomm-prod-db-rbpt/7a1e9b52-3c4d-4f6a-8e2b-9d0c1f3a5b7e
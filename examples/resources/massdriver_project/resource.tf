@@ -0,0 +1,17 @@
+resource "massdriver_project" "ecomm" {
+  id          = "ecomm"
+  name        = "E-Commerce"
+  description = "Storefront, checkout and order processing"
+
+  attributes = {
+    team = "payments"
+  }
+}
+
+# Start a new project from an existing project's blueprint. Only components
+# and links are copied; environments must be created separately.
+resource "massdriver_project" "ecomm_eu" {
+  id         = "ecommeu"
+  name       = "E-Commerce EU"
+  clone_from = massdriver_project.ecomm.id
+}
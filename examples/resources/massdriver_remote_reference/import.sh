@@ -0,0 +1,2 @@
+# Remote references are imported by instance ID and connection field.
+terraform import massdriver_remote_reference.app_vpc ecomm-prod-app:vpc
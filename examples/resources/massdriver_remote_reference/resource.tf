@@ -0,0 +1,7 @@
+# Point the app's `vpc` connection at a VPC imported by the networking team,
+# instead of drawing a link to a network component in this project.
+resource "massdriver_remote_reference" "app_vpc" {
+  instance_id = "ecomm-prod-app"
+  field       = "vpc"
+  resource_id = var.shared_vpc_resource_id
+}
@@ -0,0 +1,4 @@
+# Provisioned resources are imported by resource ID from inside a bundle
+# deployment (deployment credentials are required). The field, name, type
+# and payload are read back from Massdriver.
+terraform import massdriver_resource.vpc 2d7c4f0e-8a1b-4e5c-9f6d-3b2a1c0e9d8f
@@ -32,3 +32,33 @@ resource "massdriver_resource" "vpc" {
     }
   )
 }
+
+# Validate against the schema published in Massdriver instead of (or, with
+# "both", in addition to) the bundle's local schema-artifacts.json.
+resource "massdriver_resource" "iam_role" {
+  field             = "iam_role"
+  name              = "IAM role ${var.md_name_prefix}"
+  validation_source = "remote"
+
+  resource = jsonencode({
+    data = {
+      arn = aws_iam_role.main.arn
+    }
+  })
+}
+
+# Keep credentials out of the state file (Terraform 1.11+). `resource_wo` is
+# sent to Massdriver but never stored; state holds only `payload_sha256`.
+resource "massdriver_resource" "database" {
+  field = "database"
+  name  = "Postgres ${var.md_name_prefix}"
+
+  resource_wo = jsonencode({
+    data = {
+      authentication = {
+        username = aws_db_instance.main.username
+        password = random_password.master.result
+      }
+    }
+  })
+}
@@ -0,0 +1,2 @@
+# Resource types are imported by ID (the schema's `$md.name`).
+terraform import massdriver_resource_type.iam_role aws-iam-role
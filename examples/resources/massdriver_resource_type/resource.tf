@@ -0,0 +1,26 @@
+# Publish a custom resource type from a schema file kept in the repository.
+# The type's ID comes from the schema's `$md.name`.
+resource "massdriver_resource_type" "iam_role" {
+  schema_path = "${path.module}/resource-types/aws-iam-role.json"
+}
+
+# Or inline the schema.
+resource "massdriver_resource_type" "api_key" {
+  schema = jsonencode({
+    "$md" = {
+      name  = "vendor-api-key"
+      label = "Vendor API Key"
+    }
+    type     = "object"
+    required = ["data"]
+    properties = {
+      data = {
+        type     = "object"
+        required = ["key"]
+        properties = {
+          key = { type = "string" }
+        }
+      }
+    }
+  })
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Component is a slot in a project's blueprint, backed by a bundle.
+type Component struct {
+	ID          string             `json:"id" mapstructure:"id"`
+	Name        string             `json:"name" mapstructure:"name"`
+	Description string             `json:"description,omitempty" mapstructure:"description"`
+	Attributes  map[string]any     `json:"attributes,omitempty" mapstructure:"attributes"`
+	Position    *ComponentPosition `json:"position,omitempty" mapstructure:"position"`
+	OciRepo     OciRepo            `json:"ociRepo" mapstructure:"ociRepo"`
+	Project     Project            `json:"project" mapstructure:"project"`
+	Deletable   Deletable          `json:"deletable" mapstructure:"deletable"`
+}
+
+// ComponentPosition is a component's location on the blueprint canvas. It is
+// nil for components that have never been placed.
+type ComponentPosition struct {
+	X int `json:"x" mapstructure:"x"`
+	Y int `json:"y" mapstructure:"y"`
+}
+
+// OciRepo identifies the bundle repository a component is based on.
+type OciRepo struct {
+	Name string `json:"name" mapstructure:"name"`
+}
+
+// GetComponent retrieves a component by its full ID (e.g. `ecomm-database`).
+func GetComponent(ctx context.Context, mdClient *client.Client, id string) (*Component, error) {
+	response, err := getComponent(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component %s: %w", id, err)
+	}
+	if response.Component.Id == "" {
+		return nil, fmt.Errorf("component %s not found", id)
+	}
+	return toComponent(response.Component)
+}
+
+// AddComponent adds a component for the given bundle to a project's blueprint.
+func AddComponent(ctx context.Context, mdClient *client.Client, projectID, ociRepoName string, input AddComponentInput) (*Component, error) {
+	response, err := addComponent(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, projectID, ociRepoName, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.AddComponent.Successful {
+		messages := make([]string, 0, len(response.AddComponent.Messages))
+		for _, m := range response.AddComponent.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to add component", messages)
+	}
+	return toComponent(response.AddComponent.Result)
+}
+
+// UpdateComponent updates a component's name, description and attributes.
+func UpdateComponent(ctx context.Context, mdClient *client.Client, id string, input UpdateComponentInput) (*Component, error) {
+	response, err := updateComponent(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.UpdateComponent.Successful {
+		messages := make([]string, 0, len(response.UpdateComponent.Messages))
+		for _, m := range response.UpdateComponent.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to update component", messages)
+	}
+	return toComponent(response.UpdateComponent.Result)
+}
+
+// SetComponentPosition moves a component on the blueprint canvas.
+func SetComponentPosition(ctx context.Context, mdClient *client.Client, id string, x, y int) error {
+	response, err := setComponentPosition(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, SetComponentPositionInput{X: x, Y: y})
+	if err != nil {
+		return err
+	}
+	if !response.SetComponentPosition.Successful {
+		messages := make([]string, 0, len(response.SetComponentPosition.Messages))
+		for _, m := range response.SetComponentPosition.Messages {
+			messages = append(messages, m.Message)
+		}
+		return mutationFailure("unable to set component position", messages)
+	}
+	return nil
+}
+
+// RemoveComponent removes a component and all of its links from the
+// blueprint. The server rejects the call while instances of the component
+// are still deployed.
+func RemoveComponent(ctx context.Context, mdClient *client.Client, id string) (*Component, error) {
+	response, err := removeComponent(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.RemoveComponent.Successful {
+		messages := make([]string, 0, len(response.RemoveComponent.Messages))
+		for _, m := range response.RemoveComponent.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to remove component", messages)
+	}
+	return toComponent(response.RemoveComponent.Result)
+}
+
+func toComponent(v any) (*Component, error) {
+	c := Component{}
+	if err := decode(v, &c); err != nil {
+		return nil, fmt.Errorf("failed to decode component: %w", err)
+	}
+	return &c, nil
+}
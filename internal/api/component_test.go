@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetComponent(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"component": map[string]any{
+				"id":         "ecomm-db",
+				"name":       "Database",
+				"attributes": map[string]any{},
+				"position":   map[string]any{"x": 120, "y": 40},
+				"ociRepo":    map[string]any{"name": "aws-aurora-postgres"},
+				"project":    map[string]any{"id": "ecomm"},
+				"deletable":  map[string]any{"result": true, "constraints": []any{}},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	component, err := api.GetComponent(t.Context(), &mdClient, "ecomm-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if component.OciRepo.Name != "aws-aurora-postgres" || component.Project.ID != "ecomm" {
+		t.Errorf("got component %+v", component)
+	}
+	if component.Position == nil || component.Position.X != 120 || component.Position.Y != 40 {
+		t.Errorf("got position %+v", component.Position)
+	}
+}
+
+// A component that was never placed on the canvas has a null position, which
+// must stay nil rather than decode as (0, 0).
+func TestGetComponent_NoPosition(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"component": map[string]any{
+				"id":       "ecomm-db",
+				"name":     "Database",
+				"position": nil,
+				"project":  map[string]any{"id": "ecomm"},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	component, err := api.GetComponent(t.Context(), &mdClient, "ecomm-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if component.Position != nil {
+		t.Errorf("expected nil position, got %+v", component.Position)
+	}
+}
+
+func TestAddComponent(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"addComponent": {
+			"data": map[string]any{
+				"addComponent": map[string]any{
+					"result":     map[string]any{"id": "ecomm-db", "name": "Database"},
+					"successful": true,
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	component, err := api.AddComponent(t.Context(), &mdClient, "ecomm", "aws-aurora-postgres", api.AddComponentInput{
+		Id:   "db",
+		Name: "Database",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if component.ID != "ecomm-db" {
+		t.Errorf("got ID %s, wanted ecomm-db", component.ID)
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("addComponent"))
+	if vars["projectId"] != "ecomm" || vars["ociRepoName"] != "aws-aurora-postgres" {
+		t.Errorf("got variables %v", vars)
+	}
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deletable reports whether a project or environment can be deleted right now
+// and, if not, which resources are in the way.
+type Deletable struct {
+	Result      bool                 `json:"result" mapstructure:"result"`
+	Constraints []DeletionConstraint `json:"constraints,omitempty" mapstructure:"constraints"`
+}
+
+// DeletionConstraint is a single condition blocking deletion, e.g. an
+// environment that still exists inside a project.
+type DeletionConstraint struct {
+	Message string `json:"message" mapstructure:"message"`
+	Type    string `json:"type" mapstructure:"type"`
+	ID      string `json:"id" mapstructure:"id"`
+}
+
+// Err returns nil when deletion is allowed, otherwise a multi-line error
+// listing every blocking constraint in the same shape as mutationFailure.
+func (d Deletable) Err(prefix string) error {
+	if d.Result {
+		return nil
+	}
+	messages := make([]string, 0, len(d.Constraints))
+	for _, c := range d.Constraints {
+		messages = append(messages, fmt.Sprintf("%s %s: %s", c.Type, c.ID, strings.TrimSpace(c.Message)))
+	}
+	return mutationFailure(prefix, messages)
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Environment is a deployment target within a project (e.g. staging, prod).
+type Environment struct {
+	ID                  string         `json:"id" mapstructure:"id"`
+	Name                string         `json:"name" mapstructure:"name"`
+	Description         string         `json:"description,omitempty" mapstructure:"description"`
+	Attributes          map[string]any `json:"attributes,omitempty" mapstructure:"attributes"`
+	EffectiveAttributes map[string]any `json:"effectiveAttributes,omitempty" mapstructure:"effectiveAttributes"`
+	Project             Project        `json:"project" mapstructure:"project"`
+	Cost                CostSummary    `json:"cost" mapstructure:"cost"`
+	Deletable           Deletable      `json:"deletable" mapstructure:"deletable"`
+}
+
+// CostSummary is the aggregated cloud spend for a project or environment.
+type CostSummary struct {
+	LastMonth      CostSample `json:"lastMonth" mapstructure:"lastMonth"`
+	MonthlyAverage CostSample `json:"monthlyAverage" mapstructure:"monthlyAverage"`
+	LastDay        CostSample `json:"lastDay" mapstructure:"lastDay"`
+	DailyAverage   CostSample `json:"dailyAverage" mapstructure:"dailyAverage"`
+}
+
+// CostSample is a single cost figure. Amount is zero and Currency empty when
+// the server has no billing data for the period yet.
+type CostSample struct {
+	Amount   float64 `json:"amount,omitempty" mapstructure:"amount"`
+	Currency string  `json:"currency,omitempty" mapstructure:"currency"`
+}
+
+// GetEnvironment retrieves an environment by ID, including cost and deletion
+// constraints.
+func GetEnvironment(ctx context.Context, mdClient *client.Client, id string) (*Environment, error) {
+	response, err := getEnvironment(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment %s: %w", id, err)
+	}
+	if response.Environment.Id == "" {
+		return nil, fmt.Errorf("environment %s not found", id)
+	}
+	return toEnvironment(response.Environment)
+}
+
+// CreateEnvironment creates an empty environment in a project.
+func CreateEnvironment(ctx context.Context, mdClient *client.Client, projectID string, input CreateEnvironmentInput) (*Environment, error) {
+	response, err := createEnvironment(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, projectID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.CreateEnvironment.Successful {
+		messages := make([]string, 0, len(response.CreateEnvironment.Messages))
+		for _, m := range response.CreateEnvironment.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to create environment", messages)
+	}
+	return toEnvironment(response.CreateEnvironment.Result)
+}
+
+// ForkEnvironment creates a new environment in the parent's project, seeded
+// from the parent's instance params (and defaults, when requested).
+func ForkEnvironment(ctx context.Context, mdClient *client.Client, parentID string, input ForkEnvironmentInput) (*Environment, error) {
+	response, err := forkEnvironment(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, parentID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.ForkEnvironment.Successful {
+		messages := make([]string, 0, len(response.ForkEnvironment.Messages))
+		for _, m := range response.ForkEnvironment.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to fork environment", messages)
+	}
+	return toEnvironment(response.ForkEnvironment.Result)
+}
+
+// UpdateEnvironment updates an environment's name, description and attributes.
+func UpdateEnvironment(ctx context.Context, mdClient *client.Client, id string, input UpdateEnvironmentInput) (*Environment, error) {
+	response, err := updateEnvironment(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.UpdateEnvironment.Successful {
+		messages := make([]string, 0, len(response.UpdateEnvironment.Messages))
+		for _, m := range response.UpdateEnvironment.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to update environment", messages)
+	}
+	return toEnvironment(response.UpdateEnvironment.Result)
+}
+
+// DeleteEnvironment permanently deletes an environment. The server rejects
+// the call while instances are still provisioned; check Deletable first for
+// a descriptive error.
+func DeleteEnvironment(ctx context.Context, mdClient *client.Client, id string) (*Environment, error) {
+	response, err := deleteEnvironment(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.DeleteEnvironment.Successful {
+		messages := make([]string, 0, len(response.DeleteEnvironment.Messages))
+		for _, m := range response.DeleteEnvironment.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to delete environment", messages)
+	}
+	return toEnvironment(response.DeleteEnvironment.Result)
+}
+
+func toEnvironment(v any) (*Environment, error) {
+	e := Environment{}
+	if err := decode(v, &e); err != nil {
+		return nil, fmt.Errorf("failed to decode environment: %w", err)
+	}
+	return &e, nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// EnvironmentDefault pins a resource as the default of its type within an
+// environment, so instances needing that type are connected automatically.
+type EnvironmentDefault struct {
+	ID       string                     `json:"id" mapstructure:"id"`
+	Resource EnvironmentDefaultResource `json:"resource" mapstructure:"resource"`
+}
+
+// EnvironmentDefaultResource is the resource an environment default points at.
+type EnvironmentDefaultResource struct {
+	ID           string       `json:"id" mapstructure:"id"`
+	Name         string       `json:"name" mapstructure:"name"`
+	ResourceType ResourceType `json:"resourceType" mapstructure:"resourceType"`
+}
+
+// ListEnvironmentDefaults returns every default set on an environment,
+// walking all pages.
+func ListEnvironmentDefaults(ctx context.Context, mdClient *client.Client, environmentID string) ([]EnvironmentDefault, error) {
+	var defaults []EnvironmentDefault
+	var cursor *Cursor
+	for {
+		response, err := getEnvironmentDefaults(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, environmentID, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list defaults for environment %s: %w", environmentID, err)
+		}
+		if response.Environment.Id == "" {
+			return nil, fmt.Errorf("environment %s not found", environmentID)
+		}
+		page := response.Environment.Defaults
+		for _, item := range page.Items {
+			d := EnvironmentDefault{}
+			if err := decode(item, &d); err != nil {
+				return nil, fmt.Errorf("failed to decode environment default: %w", err)
+			}
+			defaults = append(defaults, d)
+		}
+		if page.Cursor.Next == "" {
+			return defaults, nil
+		}
+		cursor = &Cursor{Next: page.Cursor.Next}
+	}
+}
+
+// SetEnvironmentDefault makes a resource the default of its type in an
+// environment. The server rejects the call if the type already has a default.
+func SetEnvironmentDefault(ctx context.Context, mdClient *client.Client, environmentID, resourceID string) (*EnvironmentDefault, error) {
+	response, err := setEnvironmentDefault(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, environmentID, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if !response.SetEnvironmentDefault.Successful {
+		messages := make([]string, 0, len(response.SetEnvironmentDefault.Messages))
+		for _, m := range response.SetEnvironmentDefault.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to set environment default", messages)
+	}
+	return toEnvironmentDefault(response.SetEnvironmentDefault.Result)
+}
+
+// RemoveEnvironmentDefault removes an environment default by its ID. The
+// resource itself is untouched.
+func RemoveEnvironmentDefault(ctx context.Context, mdClient *client.Client, id string) (*EnvironmentDefault, error) {
+	response, err := removeEnvironmentDefault(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.RemoveEnvironmentDefault.Successful {
+		messages := make([]string, 0, len(response.RemoveEnvironmentDefault.Messages))
+		for _, m := range response.RemoveEnvironmentDefault.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to remove environment default", messages)
+	}
+	return toEnvironmentDefault(response.RemoveEnvironmentDefault.Result)
+}
+
+func toEnvironmentDefault(v any) (*EnvironmentDefault, error) {
+	d := EnvironmentDefault{}
+	if err := decode(v, &d); err != nil {
+		return nil, fmt.Errorf("failed to decode environment default: %w", err)
+	}
+	return &d, nil
+}
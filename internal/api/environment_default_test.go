@@ -0,0 +1,72 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestListEnvironmentDefaults(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"environment": map[string]any{
+				"id": "ecomm-prod",
+				"defaults": map[string]any{
+					"cursor": map[string]any{"next": nil},
+					"items": []map[string]any{
+						{
+							"id": "44444444-4444-4444-4444-444444444444",
+							"resource": map[string]any{
+								"id":           "vpc-1",
+								"name":         "Shared VPC",
+								"resourceType": map[string]any{"id": "aws-vpc"},
+							},
+						},
+						{
+							// Resource types can be hidden from the caller.
+							"id":       "55555555-5555-5555-5555-555555555555",
+							"resource": map[string]any{"id": "zone-1", "name": "Zone", "resourceType": nil},
+						},
+					},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	defaults, err := api.ListEnvironmentDefaults(t.Context(), &mdClient, "ecomm-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defaults) != 2 {
+		t.Fatalf("got %d defaults, wanted 2", len(defaults))
+	}
+	if defaults[0].Resource.ID != "vpc-1" || defaults[0].Resource.ResourceType.ID != "aws-vpc" {
+		t.Errorf("got default %+v", defaults[0])
+	}
+	if defaults[1].Resource.ResourceType.ID != "" {
+		t.Errorf("expected empty resource type, got %+v", defaults[1].Resource.ResourceType)
+	}
+}
+
+func TestSetEnvironmentDefaultFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"setEnvironmentDefault": map[string]any{
+				"successful": false,
+				"messages": []map[string]any{
+					{"field": "resourceId", "message": "environment already has a default for this resource type"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.SetEnvironmentDefault(t.Context(), &mdClient, "ecomm-prod", "vpc-2")
+	want := "unable to set environment default:\n  - environment already has a default for this resource type"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, wanted %q", err, want)
+	}
+}
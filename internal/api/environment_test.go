@@ -0,0 +1,120 @@
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetEnvironment(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"environment": map[string]any{
+				"id":                  "ecomm-prod",
+				"name":                "Production",
+				"attributes":          map[string]any{"tier": "gold"},
+				"effectiveAttributes": map[string]any{"tier": "gold", "team": "payments"},
+				"project":             map[string]any{"id": "ecomm"},
+				"cost": map[string]any{
+					"lastMonth":      map[string]any{"amount": 412.5, "currency": "USD"},
+					"monthlyAverage": map[string]any{"amount": 398.25, "currency": "USD"},
+					"lastDay":        map[string]any{"amount": nil, "currency": nil},
+					"dailyAverage":   map[string]any{"amount": 13.1, "currency": "USD"},
+				},
+				"deletable": map[string]any{"result": true, "constraints": []any{}},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	env, err := api.GetEnvironment(t.Context(), &mdClient, "ecomm-prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.ID != "ecomm-prod" || env.Project.ID != "ecomm" {
+		t.Errorf("got environment %+v", env)
+	}
+	if env.EffectiveAttributes["team"] != "payments" {
+		t.Errorf("got effective attributes %v", env.EffectiveAttributes)
+	}
+	if env.Cost.LastMonth.Amount != 412.5 || env.Cost.LastMonth.Currency != "USD" {
+		t.Errorf("got last month %+v", env.Cost.LastMonth)
+	}
+	if env.Cost.LastDay.Amount != 0 || env.Cost.LastDay.Currency != "" {
+		t.Errorf("expected empty last day sample, got %+v", env.Cost.LastDay)
+	}
+}
+
+func TestGetEnvironment_NullIsNotFound(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{"environment": nil},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.GetEnvironment(t.Context(), &mdClient, "gone")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestForkEnvironment(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"forkEnvironment": {
+			"data": map[string]any{
+				"forkEnvironment": map[string]any{
+					"result":     map[string]any{"id": "ecomm-preview", "name": "Preview"},
+					"successful": true,
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	env, err := api.ForkEnvironment(t.Context(), &mdClient, "ecomm-prod", api.ForkEnvironmentInput{
+		Id:                      "preview",
+		Name:                    "Preview",
+		CopyEnvironmentDefaults: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.ID != "ecomm-preview" {
+		t.Errorf("got ID %s, wanted ecomm-preview", env.ID)
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("forkEnvironment"))
+	if vars["parentId"] != "ecomm-prod" {
+		t.Errorf("got parentId %v, wanted ecomm-prod", vars["parentId"])
+	}
+	input := vars["input"].(map[string]any)
+	if input["copyEnvironmentDefaults"] != true {
+		t.Errorf("expected copyEnvironmentDefaults true, got %v", input)
+	}
+}
+
+func TestCreateEnvironmentFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"createEnvironment": map[string]any{
+				"successful": false,
+				"messages": []map[string]any{
+					{"field": "id", "message": "has already been taken"},
+					{"field": "attributes", "message": "tier is not an allowed attribute"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.CreateEnvironment(t.Context(), &mdClient, "ecomm", api.CreateEnvironmentInput{Id: "prod", Name: "Production"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	want := "unable to create environment:\n  - has already been taken\n  - tier is not an allowed attribute"
+	if err.Error() != want {
+		t.Errorf("got %q, wanted %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Instance is the runtime representation of a component in an environment.
+type Instance struct {
+	ID                  string             `json:"id" mapstructure:"id"`
+	Name                string             `json:"name" mapstructure:"name"`
+	Status              string             `json:"status" mapstructure:"status"`
+	Version             string             `json:"version" mapstructure:"version"`
+	ReleaseStrategy     string             `json:"releaseStrategy" mapstructure:"releaseStrategy"`
+	ResolvedVersion     string             `json:"resolvedVersion" mapstructure:"resolvedVersion"`
+	DeployedVersion     string             `json:"deployedVersion,omitempty" mapstructure:"deployedVersion"`
+	AvailableUpgrade    string             `json:"availableUpgrade,omitempty" mapstructure:"availableUpgrade"`
+	Params              map[string]any     `json:"params,omitempty" mapstructure:"params"`
+	Attributes          map[string]any     `json:"attributes,omitempty" mapstructure:"attributes"`
+	EffectiveAttributes map[string]any     `json:"effectiveAttributes,omitempty" mapstructure:"effectiveAttributes"`
+	Environment         Environment        `json:"environment" mapstructure:"environment"`
+	Component           Component          `json:"component" mapstructure:"component"`
+	Properties          []InstanceProperty `json:"properties,omitempty" mapstructure:"properties"`
+}
+
+// InstanceProperty is one scalar leaf published by an instance's resources.
+// Sensitive values arrive masked as `[SENSITIVE]`.
+type InstanceProperty struct {
+	Name  string `json:"name" mapstructure:"name"`
+	Path  string `json:"path" mapstructure:"path"`
+	Value string `json:"value,omitempty" mapstructure:"value"`
+}
+
+// GetInstance retrieves an instance by ID, including its version resolution
+// and published properties.
+func GetInstance(ctx context.Context, mdClient *client.Client, id string) (*Instance, error) {
+	response, err := getInstance(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance %s: %w", id, err)
+	}
+	if response.Instance.Id == "" {
+		return nil, fmt.Errorf("instance %s not found", id)
+	}
+	return toInstance(response.Instance)
+}
+
+func toInstance(v any) (*Instance, error) {
+	instance := Instance{}
+	if err := decode(v, &instance); err != nil {
+		return nil, fmt.Errorf("failed to decode instance: %w", err)
+	}
+	return &instance, nil
+}
@@ -1,10 +1,12 @@
 // Package api provides a small GraphQL client for the Massdriver API.
 //
-// In v1.3 this package backs the deprecated `massdriver_package_alarm`
-// resource. The package alarm REST endpoint was removed from the server, so
-// all CRUD goes through GraphQL. Provider v2.0 will replace
-// `massdriver_package_alarm` with `massdriver_instance_alarm` and expand this
-// package considerably.
+// Each file wraps the genqlient operations for one API object (instance
+// alarms, projects, ...) behind exported functions that return plain structs
+// and turn unsuccessful mutation payloads into errors via mutationFailure.
+//
+// Instance alarms also back the deprecated `massdriver_package_alarm`
+// resource: the package alarm REST endpoint was removed from the server, so
+// all of its CRUD goes through GraphQL as well.
 package api
 
 import (
@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetInstance(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"instance": map[string]any{
+				"id":               "ecomm-prod-db",
+				"name":             "db",
+				"status":           "PROVISIONED",
+				"version":          "~1.2",
+				"releaseStrategy":  "STABLE",
+				"resolvedVersion":  "1.2.3",
+				"deployedVersion":  "1.2.1",
+				"availableUpgrade": nil,
+				"params":           map[string]any{"instance_class": "db.t3.medium"},
+				"environment":      map[string]any{"id": "ecomm-prod"},
+				"component":        map[string]any{"id": "ecomm-db"},
+				"properties": []map[string]any{
+					{"name": "Database: Hostname", "path": ".database.hostname", "value": "db.internal"},
+					{"name": "Database: Password", "path": ".database.password", "value": "[SENSITIVE]"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	instance, err := api.GetInstance(t.Context(), &mdClient, "ecomm-prod-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.Status != "PROVISIONED" || instance.DeployedVersion != "1.2.1" || instance.AvailableUpgrade != "" {
+		t.Errorf("got instance %+v", instance)
+	}
+	if instance.Params["instance_class"] != "db.t3.medium" {
+		t.Errorf("got params %v", instance.Params)
+	}
+	if instance.Environment.ID != "ecomm-prod" || len(instance.Properties) != 2 {
+		t.Errorf("got environment %q and %d properties", instance.Environment.ID, len(instance.Properties))
+	}
+}
+
+func TestGetInstance_NullIsNotFound(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{"instance": nil},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.GetInstance(t.Context(), &mdClient, "gone")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Link wires an output field of one component to an input field of another.
+// The version constraints it was created with are not readable back from the
+// API.
+type Link struct {
+	ID            string    `json:"id" mapstructure:"id"`
+	FromField     string    `json:"fromField" mapstructure:"fromField"`
+	ToField       string    `json:"toField" mapstructure:"toField"`
+	FromComponent Component `json:"fromComponent" mapstructure:"fromComponent"`
+	ToComponent   Component `json:"toComponent" mapstructure:"toComponent"`
+}
+
+// GetLink looks up a link by ID among the links leaving fromComponentID.
+// Links have no top-level query, so this walks every page of the source
+// component's outgoing links.
+func GetLink(ctx context.Context, mdClient *client.Client, fromComponentID, id string) (*Link, error) {
+	var cursor *Cursor
+	for {
+		response, err := getComponentLinks(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, fromComponentID, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list links for component %s: %w", fromComponentID, err)
+		}
+		page := response.Component.Project.Blueprint.Links
+		for _, item := range page.Items {
+			if item.Id == id {
+				return toLink(item)
+			}
+		}
+		if page.Cursor.Next == "" {
+			return nil, fmt.Errorf("link %s not found", id)
+		}
+		cursor = &Cursor{Next: page.Cursor.Next}
+	}
+}
+
+// LinkComponents creates a link between two components.
+func LinkComponents(ctx context.Context, mdClient *client.Client, input LinkComponentsInput) (*Link, error) {
+	response, err := linkComponents(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.LinkComponents.Successful {
+		messages := make([]string, 0, len(response.LinkComponents.Messages))
+		for _, m := range response.LinkComponents.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to link components", messages)
+	}
+	return toLink(response.LinkComponents.Result)
+}
+
+// UnlinkComponents removes a link. Connections in already-deployed
+// environments are left in place until their next deployment.
+func UnlinkComponents(ctx context.Context, mdClient *client.Client, id string) (*Link, error) {
+	response, err := unlinkComponents(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.UnlinkComponents.Successful {
+		messages := make([]string, 0, len(response.UnlinkComponents.Messages))
+		for _, m := range response.UnlinkComponents.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to unlink components", messages)
+	}
+	return toLink(response.UnlinkComponents.Result)
+}
+
+func toLink(v any) (*Link, error) {
+	l := Link{}
+	if err := decode(v, &l); err != nil {
+		return nil, fmt.Errorf("failed to decode link: %w", err)
+	}
+	return &l, nil
+}
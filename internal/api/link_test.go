@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func componentLinksResponse(items ...map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"component": map[string]any{
+				"project": map[string]any{
+					"blueprint": map[string]any{
+						"links": map[string]any{
+							"cursor": map[string]any{"next": nil},
+							"items":  items,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetLink(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"getComponentLinks": componentLinksResponse(
+			map[string]any{
+				"id":            "11111111-1111-1111-1111-111111111111",
+				"fromField":     "network",
+				"toField":       "vpc",
+				"fromComponent": map[string]any{"id": "ecomm-net"},
+				"toComponent":   map[string]any{"id": "ecomm-cache"},
+			},
+			map[string]any{
+				"id":            "22222222-2222-2222-2222-222222222222",
+				"fromField":     "network",
+				"toField":       "network",
+				"fromComponent": map[string]any{"id": "ecomm-net"},
+				"toComponent":   map[string]any{"id": "ecomm-db"},
+			},
+		),
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	link, err := api.GetLink(t.Context(), &mdClient, "ecomm-net", "22222222-2222-2222-2222-222222222222")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link.ToComponent.ID != "ecomm-db" || link.ToField != "network" {
+		t.Errorf("got link %+v", link)
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("getComponentLinks")); vars["componentId"] != "ecomm-net" {
+		t.Errorf("got componentId %v, wanted ecomm-net", vars["componentId"])
+	}
+}
+
+func TestGetLink_NotFound(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(componentLinksResponse())
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.GetLink(t.Context(), &mdClient, "ecomm-net", "33333333-3333-3333-3333-333333333333")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestLinkComponentsFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"linkComponents": map[string]any{
+				"successful": false,
+				"messages": []map[string]any{
+					{"field": "toField", "message": "resource types are not compatible"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.LinkComponents(t.Context(), &mdClient, api.LinkComponentsInput{
+		FromComponentId: "ecomm-net",
+		FromField:       "network",
+		FromVersion:     "latest",
+		ToComponentId:   "ecomm-db",
+		ToField:         "auth",
+		ToVersion:       "latest",
+	})
+	want := "unable to link components:\n  - resource types are not compatible"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, wanted %q", err, want)
+	}
+}
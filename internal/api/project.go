@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Project groups a blueprint and its environments.
+type Project struct {
+	ID          string         `json:"id" mapstructure:"id"`
+	Name        string         `json:"name" mapstructure:"name"`
+	Description string         `json:"description,omitempty" mapstructure:"description"`
+	Attributes  map[string]any `json:"attributes,omitempty" mapstructure:"attributes"`
+	Deletable   Deletable      `json:"deletable" mapstructure:"deletable"`
+}
+
+// GetProject retrieves a project by ID, including its deletion constraints.
+func GetProject(ctx context.Context, mdClient *client.Client, id string) (*Project, error) {
+	response, err := getProject(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", id, err)
+	}
+	if response.Project.Id == "" {
+		return nil, fmt.Errorf("project %s not found", id)
+	}
+	return toProject(response.Project)
+}
+
+// CreateProject creates an empty project.
+func CreateProject(ctx context.Context, mdClient *client.Client, input CreateProjectInput) (*Project, error) {
+	response, err := createProject(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.CreateProject.Successful {
+		messages := make([]string, 0, len(response.CreateProject.Messages))
+		for _, m := range response.CreateProject.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to create project", messages)
+	}
+	return toProject(response.CreateProject.Result)
+}
+
+// CloneProject creates a new project whose blueprint is copied from
+// sourceProjectID. Environments are not cloned.
+func CloneProject(ctx context.Context, mdClient *client.Client, sourceProjectID string, input CloneProjectInput) (*Project, error) {
+	response, err := cloneProject(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, sourceProjectID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.CloneProject.Successful {
+		messages := make([]string, 0, len(response.CloneProject.Messages))
+		for _, m := range response.CloneProject.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to clone project", messages)
+	}
+	return toProject(response.CloneProject.Result)
+}
+
+// UpdateProject updates a project's name, description and attributes.
+func UpdateProject(ctx context.Context, mdClient *client.Client, id string, input UpdateProjectInput) (*Project, error) {
+	response, err := updateProject(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.UpdateProject.Successful {
+		messages := make([]string, 0, len(response.UpdateProject.Messages))
+		for _, m := range response.UpdateProject.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to update project", messages)
+	}
+	return toProject(response.UpdateProject.Result)
+}
+
+// DeleteProject permanently deletes a project. The server rejects the call
+// while the project still has environments; check Deletable first for a
+// descriptive error.
+func DeleteProject(ctx context.Context, mdClient *client.Client, id string) (*Project, error) {
+	response, err := deleteProject(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.DeleteProject.Successful {
+		messages := make([]string, 0, len(response.DeleteProject.Messages))
+		for _, m := range response.DeleteProject.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to delete project", messages)
+	}
+	return toProject(response.DeleteProject.Result)
+}
+
+func toProject(v any) (*Project, error) {
+	p := Project{}
+	if err := decode(v, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode project: %w", err)
+	}
+	return &p, nil
+}
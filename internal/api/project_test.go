@@ -0,0 +1,189 @@
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetProject(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"project": map[string]any{
+				"id":          "ecomm",
+				"name":        "E-Commerce",
+				"description": "Storefront",
+				"attributes":  map[string]any{"team": "payments"},
+				"deletable": map[string]any{
+					"result": false,
+					"constraints": []map[string]any{
+						{"message": "project has environments", "type": "environment", "id": "ecomm-prod"},
+					},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	project, err := api.GetProject(t.Context(), &mdClient, "ecomm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ID != "ecomm" || project.Name != "E-Commerce" || project.Description != "Storefront" {
+		t.Errorf("got project %+v", project)
+	}
+	if project.Attributes["team"] != "payments" {
+		t.Errorf("got attributes %v, wanted team=payments", project.Attributes)
+	}
+	if project.Deletable.Result {
+		t.Error("expected deletable.result false")
+	}
+	if len(project.Deletable.Constraints) != 1 || project.Deletable.Constraints[0].ID != "ecomm-prod" {
+		t.Errorf("got constraints %+v", project.Deletable.Constraints)
+	}
+}
+
+// A null `project` with no GraphQL error still has to read as "not found" so
+// the resource can drop it from state.
+func TestGetProject_NullIsNotFound(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{"project": nil},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.GetProject(t.Context(), &mdClient, "gone")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestCreateProject(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"createProject": {
+			"data": map[string]any{
+				"createProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm", "name": "E-Commerce"},
+					"successful": true,
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	project, err := api.CreateProject(t.Context(), &mdClient, api.CreateProjectInput{
+		Id:   "ecomm",
+		Name: "E-Commerce",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ID != "ecomm" {
+		t.Errorf("got ID %s, wanted ecomm", project.ID)
+	}
+
+	// Unset description/attributes must be dropped from the wire, not sent
+	// as empty values.
+	input := gqlmock.Variables(rec.FindRequest("createProject"))["input"].(map[string]any)
+	if _, ok := input["description"]; ok {
+		t.Errorf("description should be omitted when empty, got %v", input)
+	}
+	if _, ok := input["attributes"]; ok {
+		t.Errorf("attributes should be omitted when empty, got %v", input)
+	}
+}
+
+func TestCloneProject(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"cloneProject": {
+			"data": map[string]any{
+				"cloneProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm2", "name": "E-Commerce 2"},
+					"successful": true,
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	project, err := api.CloneProject(t.Context(), &mdClient, "ecomm", api.CloneProjectInput{
+		Id:   "ecomm2",
+		Name: "E-Commerce 2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ID != "ecomm2" {
+		t.Errorf("got ID %s, wanted ecomm2", project.ID)
+	}
+	if got := gqlmock.Variables(rec.FindRequest("cloneProject"))["sourceProjectId"]; got != "ecomm" {
+		t.Errorf("got sourceProjectId %v, wanted ecomm", got)
+	}
+}
+
+func TestUpdateProjectFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"updateProject": map[string]any{
+				"result":     nil,
+				"successful": false,
+				"messages": []map[string]any{
+					{"code": "validation", "field": "name", "message": "has already been taken"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.UpdateProject(t.Context(), &mdClient, "ecomm", api.UpdateProjectInput{Name: "Taken"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "has already been taken") {
+		t.Errorf("error %q should carry the server message", err)
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"deleteProject": map[string]any{
+				"result":     map[string]any{"id": "ecomm", "name": "E-Commerce"},
+				"successful": true,
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	project, err := api.DeleteProject(t.Context(), &mdClient, "ecomm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.ID != "ecomm" {
+		t.Errorf("got ID %s, wanted ecomm", project.ID)
+	}
+}
+
+func TestDeletableErr(t *testing.T) {
+	if err := (api.Deletable{Result: true}).Err("blocked"); err != nil {
+		t.Errorf("expected nil for deletable resource, got %v", err)
+	}
+
+	err := api.Deletable{
+		Result: false,
+		Constraints: []api.DeletionConstraint{
+			{Message: "has instances", Type: "instance", ID: "ecomm-prod-db"},
+			{Message: "has forks", Type: "environment", ID: "ecomm-prodfork"},
+		},
+	}.Err("blocked")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	for _, want := range []string{"blocked:", "instance ecomm-prod-db: has instances", "environment ecomm-prodfork: has forks"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
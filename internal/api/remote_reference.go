@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Kinds of InstanceDependency.Source, matching the GraphQL union members.
+const (
+	DependencySourceConnection         = "Connection"
+	DependencySourceRemoteReference    = "RemoteReference"
+	DependencySourceEnvironmentDefault = "EnvironmentDefault"
+)
+
+// RemoteReference overrides one of an instance's connection slots with a
+// resource from another project or an imported resource.
+type RemoteReference struct {
+	ID       string   `json:"id" mapstructure:"id"`
+	Field    string   `json:"field" mapstructure:"field"`
+	Resource Resource `json:"resource" mapstructure:"resource"`
+}
+
+// InstanceDependency is one filled connection slot on an instance.
+type InstanceDependency struct {
+	Field    string
+	Resource Resource
+	Source   DependencySource
+}
+
+// DependencySource says what fills a slot. Kind is one of the
+// DependencySource* constants; FromInstanceID and FromField are only set for
+// blueprint connections.
+type DependencySource struct {
+	Kind           string
+	ID             string
+	FromInstanceID string
+	FromField      string
+}
+
+// GetInstanceDependencies lists the filled connection slots on an instance.
+func GetInstanceDependencies(ctx context.Context, mdClient *client.Client, instanceID string) ([]InstanceDependency, error) {
+	response, err := getInstanceDependencies(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependencies for instance %s: %w", instanceID, err)
+	}
+	if response.Instance.Id == "" {
+		return nil, fmt.Errorf("instance %s not found", instanceID)
+	}
+
+	deps := make([]InstanceDependency, 0, len(response.Instance.Dependencies))
+	for _, dep := range response.Instance.Dependencies {
+		d := InstanceDependency{
+			Field:    dep.Field,
+			Resource: Resource{ID: dep.Resource.Id, Name: dep.Resource.Name},
+		}
+		switch src := dep.Source.(type) {
+		case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection:
+			d.Source = DependencySource{Kind: DependencySourceConnection, ID: src.Id, FromField: src.FromField}
+			if src.FromInstance != nil {
+				d.Source.FromInstanceID = src.FromInstance.Id
+			}
+		case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference:
+			d.Source = DependencySource{Kind: DependencySourceRemoteReference, ID: src.Id}
+		case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault:
+			d.Source = DependencySource{Kind: DependencySourceEnvironmentDefault, ID: src.Id}
+		}
+		deps = append(deps, d)
+	}
+	return deps, nil
+}
+
+// SetRemoteReference points an instance's connection slot at a resource.
+// resourceID is a UUID for imported resources or `instance.field` for
+// provisioned ones. The instance must not be provisioned or failed.
+func SetRemoteReference(ctx context.Context, mdClient *client.Client, instanceID, resourceID, field string) (*RemoteReference, error) {
+	response, err := setRemoteReference(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, instanceID, resourceID, SetRemoteReferenceInput{Field: field})
+	if err != nil {
+		return nil, err
+	}
+	if !response.SetRemoteReference.Successful {
+		messages := make([]string, 0, len(response.SetRemoteReference.Messages))
+		for _, m := range response.SetRemoteReference.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to set remote reference", messages)
+	}
+	return toRemoteReference(response.SetRemoteReference.Result)
+}
+
+// RemoveRemoteReference removes the override on an instance's slot, which
+// reverts to its blueprint link or environment default at the next deploy.
+func RemoveRemoteReference(ctx context.Context, mdClient *client.Client, instanceID, field string) (*RemoteReference, error) {
+	response, err := removeRemoteReference(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, instanceID, RemoveRemoteReferenceInput{Field: field})
+	if err != nil {
+		return nil, err
+	}
+	if !response.RemoveRemoteReference.Successful {
+		messages := make([]string, 0, len(response.RemoveRemoteReference.Messages))
+		for _, m := range response.RemoveRemoteReference.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to remove remote reference", messages)
+	}
+	return toRemoteReference(response.RemoveRemoteReference.Result)
+}
+
+func toRemoteReference(v any) (*RemoteReference, error) {
+	r := RemoteReference{}
+	if err := decode(v, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode remote reference: %w", err)
+	}
+	return &r, nil
+}
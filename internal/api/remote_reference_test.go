@@ -0,0 +1,98 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetInstanceDependencies(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"instance": map[string]any{
+				"id": "ecomm-prod-app",
+				"dependencies": []map[string]any{
+					{
+						"field":    "database",
+						"resource": map[string]any{"id": "ecomm-prod-db.authentication", "name": "db auth"},
+						"source": map[string]any{
+							"__typename":   "Connection",
+							"id":           "c-1",
+							"fromField":    "authentication",
+							"fromInstance": map[string]any{"id": "ecomm-prod-db"},
+						},
+					},
+					{
+						"field":    "vpc",
+						"resource": map[string]any{"id": "vpc-1", "name": "Shared VPC"},
+						"source":   map[string]any{"__typename": "RemoteReference", "id": "r-1"},
+					},
+					{
+						"field":    "aws_authentication",
+						"resource": map[string]any{"id": "creds-1", "name": "Prod creds"},
+						"source":   map[string]any{"__typename": "EnvironmentDefault", "id": "d-1"},
+					},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	deps, err := api.GetInstanceDependencies(t.Context(), &mdClient, "ecomm-prod-app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 3 {
+		t.Fatalf("got %d dependencies, wanted 3", len(deps))
+	}
+
+	want := []api.DependencySource{
+		{Kind: api.DependencySourceConnection, ID: "c-1", FromInstanceID: "ecomm-prod-db", FromField: "authentication"},
+		{Kind: api.DependencySourceRemoteReference, ID: "r-1"},
+		{Kind: api.DependencySourceEnvironmentDefault, ID: "d-1"},
+	}
+	for i, w := range want {
+		if deps[i].Source != w {
+			t.Errorf("dependency %d: got source %+v, wanted %+v", i, deps[i].Source, w)
+		}
+	}
+	if deps[1].Resource.ID != "vpc-1" || deps[1].Field != "vpc" {
+		t.Errorf("got dependency %+v", deps[1])
+	}
+}
+
+func TestSetRemoteReference(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"setRemoteReference": {
+			"data": map[string]any{
+				"setRemoteReference": map[string]any{
+					"result": map[string]any{
+						"id":       "r-1",
+						"field":    "vpc",
+						"resource": map[string]any{"id": "vpc-1", "name": "Shared VPC"},
+					},
+					"successful": true,
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	ref, err := api.SetRemoteReference(t.Context(), &mdClient, "ecomm-prod-app", "vpc-1", "vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Resource.Name != "Shared VPC" {
+		t.Errorf("got reference %+v", ref)
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("setRemoteReference"))
+	if vars["instanceId"] != "ecomm-prod-app" || vars["resourceId"] != "vpc-1" {
+		t.Errorf("got variables %v", vars)
+	}
+	if input := vars["input"].(map[string]any); input["field"] != "vpc" {
+		t.Errorf("got input %v", input)
+	}
+}
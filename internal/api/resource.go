@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// Resource is a piece of infrastructure data (credentials, network, ...)
+// produced by a deployment or imported by hand.
+type Resource struct {
+	ID           string         `json:"id" mapstructure:"id"`
+	Name         string         `json:"name" mapstructure:"name"`
+	Origin       string         `json:"origin,omitempty" mapstructure:"origin"`
+	Field        string         `json:"field,omitempty" mapstructure:"field"`
+	Formats      []string       `json:"formats,omitempty" mapstructure:"formats"`
+	Payload      map[string]any `json:"payload,omitempty" mapstructure:"payload"`
+	Attributes   map[string]any `json:"attributes,omitempty" mapstructure:"attributes"`
+	ResourceType ResourceType   `json:"resourceType" mapstructure:"resourceType"`
+	Instance     Instance       `json:"instance" mapstructure:"instance"`
+}
+
+// ExportedResource is a resource with its sensitive payload values revealed,
+// plus the payload rendered in the requested format.
+type ExportedResource struct {
+	ID           string         `json:"id" mapstructure:"id"`
+	Name         string         `json:"name" mapstructure:"name"`
+	Origin       string         `json:"origin,omitempty" mapstructure:"origin"`
+	Payload      map[string]any `json:"payload,omitempty" mapstructure:"payload"`
+	Rendered     string         `json:"rendered" mapstructure:"rendered"`
+	ResourceType ResourceType   `json:"resourceType" mapstructure:"resourceType"`
+}
+
+// GetResource retrieves a resource by ID. Payload fields marked
+// `$md.sensitive` come back masked as `[SENSITIVE]`; use ExportResource for
+// the real values.
+func GetResource(ctx context.Context, mdClient *client.Client, id string) (*Resource, error) {
+	response, err := getResource(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource %s: %w", id, err)
+	}
+	if response.Resource.Id == "" {
+		return nil, fmt.Errorf("resource %s not found", id)
+	}
+	return toResource(response.Resource)
+}
+
+// ListResources returns every resource matching filter, walking all pages.
+// A nil filter lists all resources visible to the caller.
+func ListResources(ctx context.Context, mdClient *client.Client, filter *ResourcesFilter) ([]Resource, error) {
+	var out []Resource
+	var cursor *Cursor
+	for {
+		response, err := listResources(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, filter, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources: %w", err)
+		}
+		for _, item := range response.Resources.Items {
+			r, err := toResource(item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, *r)
+		}
+		next := response.Resources.Cursor.Next
+		if next == "" {
+			return out, nil
+		}
+		cursor = &Cursor{Next: next}
+	}
+}
+
+// ExportResource fetches a resource with its sensitive values unmasked. The
+// server records every call in the audit log. An empty format renders JSON.
+func ExportResource(ctx context.Context, mdClient *client.Client, id, format string) (*ExportedResource, error) {
+	response, err := exportResource(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, format)
+	if err != nil {
+		return nil, err
+	}
+	if !response.ExportResource.Successful {
+		messages := make([]string, 0, len(response.ExportResource.Messages))
+		for _, m := range response.ExportResource.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to export resource", messages)
+	}
+	exported := ExportedResource{}
+	if err := decode(response.ExportResource.Result, &exported); err != nil {
+		return nil, fmt.Errorf("failed to decode exported resource: %w", err)
+	}
+	return &exported, nil
+}
+
+// CreateResource imports a resource of the given type. The server validates
+// the payload against the type's schema.
+func CreateResource(ctx context.Context, mdClient *client.Client, resourceTypeID string, input CreateResourceInput) (*Resource, error) {
+	response, err := createResource(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, resourceTypeID, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.CreateResource.Successful {
+		messages := make([]string, 0, len(response.CreateResource.Messages))
+		for _, m := range response.CreateResource.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to create resource", messages)
+	}
+	return toResource(response.CreateResource.Result)
+}
+
+// UpdateResource updates a resource's name and, for imported resources, its
+// payload.
+func UpdateResource(ctx context.Context, mdClient *client.Client, id string, input UpdateResourceInput) (*Resource, error) {
+	response, err := updateResource(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if !response.UpdateResource.Successful {
+		messages := make([]string, 0, len(response.UpdateResource.Messages))
+		for _, m := range response.UpdateResource.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to update resource", messages)
+	}
+	return toResource(response.UpdateResource.Result)
+}
+
+// DeleteResource deletes an imported resource.
+func DeleteResource(ctx context.Context, mdClient *client.Client, id string) (*Resource, error) {
+	response, err := deleteResource(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.DeleteResource.Successful {
+		messages := make([]string, 0, len(response.DeleteResource.Messages))
+		for _, m := range response.DeleteResource.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to delete resource", messages)
+	}
+	return toResource(response.DeleteResource.Result)
+}
+
+func toResource(v any) (*Resource, error) {
+	r := Resource{}
+	if err := decode(v, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode resource: %w", err)
+	}
+	return &r, nil
+}
@@ -0,0 +1,204 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	api "terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func TestGetResource(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"resource": map[string]any{
+				"id":           "66666666-6666-6666-6666-666666666666",
+				"name":         "CI role",
+				"origin":       "IMPORTED",
+				"attributes":   map[string]any{"team": "platform"},
+				"resourceType": map[string]any{"id": "aws-iam-role"},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	resource, err := api.GetResource(t.Context(), &mdClient, "66666666-6666-6666-6666-666666666666")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resource.Origin != "IMPORTED" || resource.ResourceType.ID != "aws-iam-role" {
+		t.Errorf("got resource %+v", resource)
+	}
+	if resource.Attributes["team"] != "platform" {
+		t.Errorf("got attributes %v", resource.Attributes)
+	}
+}
+
+func TestCreateResourceFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"createResource": map[string]any{
+				"successful": false,
+				"messages": []map[string]any{
+					{"field": "payload", "message": "data.arn is required"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.CreateResource(t.Context(), &mdClient, "aws-iam-role", api.CreateResourceInput{Name: "CI role"})
+	want := "unable to create resource:\n  - data.arn is required"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, wanted %q", err, want)
+	}
+}
+
+func TestGetResourceType(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"resourceType": map[string]any{
+				"id":     "aws-iam-role",
+				"name":   "AWS IAM Role",
+				"schema": map[string]any{"type": "object"},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	rt, err := api.GetResourceType(t.Context(), &mdClient, "aws-iam-role")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt.Name != "AWS IAM Role" || rt.Schema["type"] != "object" {
+		t.Errorf("got resource type %+v", rt)
+	}
+}
+
+func TestGetResourceType_NullIsNotFound(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{"resourceType": nil},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.GetResourceType(t.Context(), &mdClient, "gone")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestListResources(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"listResources": {
+			"data": map[string]any{
+				"resources": map[string]any{
+					"cursor": map[string]any{"next": nil},
+					"items": []map[string]any{
+						{"id": "r-1", "name": "CI role", "origin": "IMPORTED", "resourceType": map[string]any{"id": "aws-iam-role"}},
+						{"id": "r-2", "name": "Deploy role", "origin": "PROVISIONED", "resourceType": map[string]any{"id": "aws-iam-role"}},
+					},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	resources, err := api.ListResources(t.Context(), &mdClient, &api.ResourcesFilter{
+		ResourceType: &api.StringFilter{Eq: "aws-iam-role"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 2 || resources[1].Origin != "PROVISIONED" {
+		t.Errorf("got resources %+v", resources)
+	}
+	filter := gqlmock.Variables(rec.FindRequest("listResources"))["filter"].(map[string]any)
+	if filter["resourceType"].(map[string]any)["eq"] != "aws-iam-role" {
+		t.Errorf("got filter %v", filter)
+	}
+}
+
+func TestPublishResourceTypeFailure(t *testing.T) {
+	gqlClient := gqlmock.NewClientWithSingleJSONResponse(map[string]any{
+		"data": map[string]any{
+			"publishResourceType": map[string]any{
+				"successful": false,
+				"messages": []map[string]any{
+					{"field": "schema", "message": "$md.name is reserved"},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	_, err := api.PublishResourceType(t.Context(), &mdClient, map[string]any{"type": "object"})
+	want := "unable to publish resource type:\n  - $md.name is reserved"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, wanted %q", err, want)
+	}
+}
+
+func TestExportResourceOmitsEmptyFormat(t *testing.T) {
+	rec := gqlmock.NewClientWithResponses(map[string]map[string]any{
+		"exportResource": {
+			"data": map[string]any{
+				"exportResource": map[string]any{
+					"successful": true,
+					"result": map[string]any{
+						"id":       "r-1",
+						"payload":  map[string]any{"password": "hunter2"},
+						"rendered": `{"password":"hunter2"}`,
+					},
+				},
+			},
+		},
+	})
+	mdClient := client.Client{GQLv2: rec}
+
+	exported, err := api.ExportResource(t.Context(), &mdClient, "r-1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exported.Payload["password"] != "hunter2" || exported.Rendered != `{"password":"hunter2"}` {
+		t.Errorf("got exported resource %+v", exported)
+	}
+	if _, ok := gqlmock.Variables(rec.FindRequest("exportResource"))["format"]; ok {
+		t.Error("an empty format should be omitted so the server defaults to json")
+	}
+}
+
+func TestListResources_FollowsCursor(t *testing.T) {
+	page := 0
+	gqlClient := gqlClientFunc(func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		page++
+		var body string
+		switch page {
+		case 1:
+			body = `{"data":{"resources":{"cursor":{"next":"page2"},"items":[{"id":"r-1","name":"vpc-a","origin":"IMPORTED","field":null}]}}}`
+		case 2:
+			body = `{"data":{"resources":{"cursor":{"next":""},"items":[{"id":"r-2","name":"vpc-b","origin":"IMPORTED","field":null}]}}}`
+		default:
+			t.Fatalf("unexpected page request %d", page)
+		}
+		var envelope struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(body), &envelope); err != nil {
+			return err
+		}
+		return json.Unmarshal(envelope.Data, resp.Data)
+	})
+	mdClient := client.Client{GQLv2: gqlClient}
+
+	resources, err := api.ListResources(t.Context(), &mdClient, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 2 || resources[1].ID != "r-2" {
+		t.Errorf("got resources %+v", resources)
+	}
+}
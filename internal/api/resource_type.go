@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+// ResourceType is the schema a resource conforms to (e.g. `aws-vpc`).
+type ResourceType struct {
+	ID                    string              `json:"id" mapstructure:"id"`
+	Name                  string              `json:"name,omitempty" mapstructure:"name"`
+	Icon                  string              `json:"icon,omitempty" mapstructure:"icon"`
+	ConnectionOrientation string              `json:"connectionOrientation,omitempty" mapstructure:"connectionOrientation"`
+	Schema                map[string]any      `json:"schema,omitempty" mapstructure:"schema"`
+	UISchema              map[string]any      `json:"uiSchema,omitempty" mapstructure:"uiSchema"`
+	Instructions          []ImportInstruction `json:"instructions,omitempty" mapstructure:"instructions"`
+}
+
+// ImportInstruction is one workflow (CLI, console, ...) for gathering the
+// data needed to import a resource of a given type.
+type ImportInstruction struct {
+	Label   string `json:"label" mapstructure:"label"`
+	Content string `json:"content" mapstructure:"content"`
+}
+
+// GetResourceType retrieves a resource type, including its JSON Schema, UI
+// schema and import instructions.
+func GetResourceType(ctx context.Context, mdClient *client.Client, id string) (*ResourceType, error) {
+	response, err := getResourceType(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource type %s: %w", id, err)
+	}
+	if response.ResourceType.Id == "" {
+		return nil, fmt.Errorf("resource type %s not found", id)
+	}
+	return toResourceType(response.ResourceType)
+}
+
+// PublishResourceType creates or replaces the resource type identified by the
+// schema's `$md.name`.
+func PublishResourceType(ctx context.Context, mdClient *client.Client, schema map[string]any) (*ResourceType, error) {
+	response, err := publishResourceType(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, PublishResourceTypeInput{Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+	if !response.PublishResourceType.Successful {
+		messages := make([]string, 0, len(response.PublishResourceType.Messages))
+		for _, m := range response.PublishResourceType.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to publish resource type", messages)
+	}
+	return toResourceType(response.PublishResourceType.Result)
+}
+
+// DeleteResourceType deletes a resource type. The server rejects the call
+// while bundles or resources still use the type.
+func DeleteResourceType(ctx context.Context, mdClient *client.Client, id string) (*ResourceType, error) {
+	response, err := deleteResourceType(ctx, mdClient.GQLv2, mdClient.Config.OrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !response.DeleteResourceType.Successful {
+		messages := make([]string, 0, len(response.DeleteResourceType.Messages))
+		for _, m := range response.DeleteResourceType.Messages {
+			messages = append(messages, m.Message)
+		}
+		return nil, mutationFailure("unable to delete resource type", messages)
+	}
+	return toResourceType(response.DeleteResourceType.Result)
+}
+
+func toResourceType(v any) (*ResourceType, error) {
+	rt := ResourceType{}
+	if err := decode(v, &rt); err != nil {
+		return nil, fmt.Errorf("failed to decode resource type: %w", err)
+	}
+	return &rt, nil
+}
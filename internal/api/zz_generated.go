@@ -4,10 +4,109 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-massdriver/internal/api/scalars"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
+// Add an infrastructure component to a project's blueprint. Each component is a specific instance of a bundle (like a Redis cache or PostgreSQL database) that composes with other components to form your application.
+type AddComponentInput struct {
+	// Key-value attributes for this component. Keys and values must be strings. Must conform to the organization's custom attributes for the component scope.
+	Attributes map[string]any `json:"-"`
+	// Optional description of this component's purpose
+	Description string `json:"description,omitempty"`
+	// A short, memorable identifier for this component. This becomes the final segment of package identifiers. For example, project 'ecomm' with environment 'prod' and component 'db' creates 'ecomm-prod-db'. Max 20 characters, lowercase alphanumeric only (a-z, 0-9). Immutable after creation.
+	Id string `json:"id"`
+	// Display name for this component (e.g., 'Billing Database')
+	Name string `json:"name"`
+}
+
+// GetAttributes returns AddComponentInput.Attributes, and is useful for accessing the field via an interface.
+func (v *AddComponentInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns AddComponentInput.Description, and is useful for accessing the field via an interface.
+func (v *AddComponentInput) GetDescription() string { return v.Description }
+
+// GetId returns AddComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *AddComponentInput) GetId() string { return v.Id }
+
+// GetName returns AddComponentInput.Name, and is useful for accessing the field via an interface.
+func (v *AddComponentInput) GetName() string { return v.Name }
+
+func (v *AddComponentInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*AddComponentInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.AddComponentInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal AddComponentInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalAddComponentInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+}
+
+func (v *AddComponentInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *AddComponentInput) __premarshalJSON() (*__premarshalAddComponentInput, error) {
+	var retval __premarshalAddComponentInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal AddComponentInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Id = v.Id
+	retval.Name = v.Name
+	return &retval, nil
+}
+
 // A key-value dimension identifying the cloud resource a metric applies to.
 type AlarmMetricDimensionInput struct {
 	// Dimension name as defined by the cloud provider.
@@ -55,6 +154,216 @@ func (v *AlarmMetricInput) GetRegion() string { return v.Region }
 // GetDimensions returns AlarmMetricInput.Dimensions, and is useful for accessing the field via an interface.
 func (v *AlarmMetricInput) GetDimensions() []AlarmMetricDimensionInput { return v.Dimensions }
 
+// Attributes for the new project.
+type CloneProjectInput struct {
+	// Key-value attributes for this project. Keys and values must be strings. Must conform to the organization's custom attributes for the project scope.
+	Attributes map[string]any `json:"-"`
+	// An optional description of the project's purpose or contents
+	Description string `json:"description,omitempty"`
+	// A short, memorable identifier for looking up this project in the API and CLI. This becomes the first segment of all resource identifiers within the project. Max 20 characters, lowercase alphanumeric only (a-z, 0-9). Immutable after creation.
+	Id string `json:"id"`
+	// A human-readable name for the new project
+	Name string `json:"name"`
+}
+
+// GetAttributes returns CloneProjectInput.Attributes, and is useful for accessing the field via an interface.
+func (v *CloneProjectInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns CloneProjectInput.Description, and is useful for accessing the field via an interface.
+func (v *CloneProjectInput) GetDescription() string { return v.Description }
+
+// GetId returns CloneProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *CloneProjectInput) GetId() string { return v.Id }
+
+// GetName returns CloneProjectInput.Name, and is useful for accessing the field via an interface.
+func (v *CloneProjectInput) GetName() string { return v.Name }
+
+func (v *CloneProjectInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CloneProjectInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CloneProjectInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal CloneProjectInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCloneProjectInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+}
+
+func (v *CloneProjectInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CloneProjectInput) __premarshalJSON() (*__premarshalCloneProjectInput, error) {
+	var retval __premarshalCloneProjectInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CloneProjectInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Id = v.Id
+	retval.Name = v.Name
+	return &retval, nil
+}
+
+// Determines how instances receive a dependency of this resource type.
+//
+// When a bundle declares a dependency, the connection orientation of the
+// dependency's resource type controls how it gets satisfied at deploy time.
+type ConnectionOrientation string
+
+const (
+	// The dependency is wired explicitly by drawing a connection between two instances on the canvas. The user chooses which specific instance provides the resource.
+	ConnectionOrientationLink ConnectionOrientation = "LINK"
+	// The dependency is satisfied automatically by an environment-level default. The resource is shared across all instances in the environment without explicit wiring.
+	ConnectionOrientationEnvironmentDefault ConnectionOrientation = "ENVIRONMENT_DEFAULT"
+)
+
+var AllConnectionOrientation = []ConnectionOrientation{
+	ConnectionOrientationLink,
+	ConnectionOrientationEnvironmentDefault,
+}
+
+// Create a new environment. Environments are isolated deployment contexts like production, staging, or development, each with independent secrets and configurations.
+type CreateEnvironmentInput struct {
+	// Key-value attributes for this environment. Keys and values must be strings. Must conform to the organization's custom attributes for the environment scope.
+	Attributes map[string]any `json:"-"`
+	// An optional description of the environment's purpose
+	Description string `json:"description,omitempty"`
+	// A short, memorable identifier for looking up this environment in the API and CLI. This becomes the second segment of package identifiers. For example, project 'ecomm' with environment 'prod' and component 'db' creates 'ecomm-prod-db'. Use familiar names like 'prod', 'staging', 'dev'—human-readable, not a UUID. Max 20 characters, lowercase alphanumeric only (a-z, 0-9). Immutable after creation.
+	Id string `json:"id"`
+	// A human-readable name for the environment
+	Name string `json:"name"`
+}
+
+// GetAttributes returns CreateEnvironmentInput.Attributes, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns CreateEnvironmentInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetDescription() string { return v.Description }
+
+// GetId returns CreateEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetId() string { return v.Id }
+
+// GetName returns CreateEnvironmentInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateEnvironmentInput) GetName() string { return v.Name }
+
+func (v *CreateEnvironmentInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateEnvironmentInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateEnvironmentInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal CreateEnvironmentInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCreateEnvironmentInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+}
+
+func (v *CreateEnvironmentInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateEnvironmentInput) __premarshalJSON() (*__premarshalCreateEnvironmentInput, error) {
+	var retval __premarshalCreateEnvironmentInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CreateEnvironmentInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Id = v.Id
+	retval.Name = v.Name
+	return &retval, nil
+}
+
 // Register a cloud metric alarm with an instance. The alarm appears in the UI immediately and receives state transitions as soon as the cloud provider reports them. Webhooks from AWS CloudWatch, Azure Monitor, GCP Cloud Monitoring, and Prometheus Alertmanager match against `cloudResourceId` to attach state.
 type CreateInstanceAlarmInput struct {
 	// The cloud provider's unique identifier for the alarm. Used to correlate incoming state transition webhooks back to this alarm. Examples: a CloudWatch AlarmArn, a GCP alert policy name, an Azure alert id.
@@ -89,6 +398,182 @@ func (v *CreateInstanceAlarmInput) GetPeriod() *int { return v.Period }
 // GetThreshold returns CreateInstanceAlarmInput.Threshold, and is useful for accessing the field via an interface.
 func (v *CreateInstanceAlarmInput) GetThreshold() *float64 { return v.Threshold }
 
+// Create a new project. A project is the complete model of your application—its infrastructure, architecture, configurations, and environments.
+type CreateProjectInput struct {
+	// Key-value attributes for this project. Keys and values must be strings. Must conform to the organization's custom attributes for the project scope.
+	Attributes map[string]any `json:"-"`
+	// An optional description of the project's purpose or contents
+	Description string `json:"description,omitempty"`
+	// A short, memorable identifier for looking up this project in the API and CLI. This becomes the first segment of all resource identifiers within the project. For example, a project 'ecomm' with environment 'prod' and component 'db' creates the package identifier 'ecomm-prod-db'. Choose something concise and meaningful—human-readable, not a UUID. Max 20 characters, lowercase alphanumeric only (a-z, 0-9). Immutable after creation.
+	Id string `json:"id"`
+	// A human-readable name for the project
+	Name string `json:"name"`
+}
+
+// GetAttributes returns CreateProjectInput.Attributes, and is useful for accessing the field via an interface.
+func (v *CreateProjectInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns CreateProjectInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateProjectInput) GetDescription() string { return v.Description }
+
+// GetId returns CreateProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *CreateProjectInput) GetId() string { return v.Id }
+
+// GetName returns CreateProjectInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateProjectInput) GetName() string { return v.Name }
+
+func (v *CreateProjectInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateProjectInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateProjectInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal CreateProjectInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCreateProjectInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+}
+
+func (v *CreateProjectInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateProjectInput) __premarshalJSON() (*__premarshalCreateProjectInput, error) {
+	var retval __premarshalCreateProjectInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CreateProjectInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Id = v.Id
+	retval.Name = v.Name
+	return &retval, nil
+}
+
+// Import a new resource with a name and optional payload conforming to the resource type's schema.
+type CreateResourceInput struct {
+	// A human-readable name for this resource
+	Name string `json:"name"`
+	// Resource data conforming to the resource type's schema. Structure varies by type.
+	Payload map[string]any `json:"-"`
+}
+
+// GetName returns CreateResourceInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateResourceInput) GetName() string { return v.Name }
+
+// GetPayload returns CreateResourceInput.Payload, and is useful for accessing the field via an interface.
+func (v *CreateResourceInput) GetPayload() map[string]any { return v.Payload }
+
+func (v *CreateResourceInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateResourceInput
+		Payload json.RawMessage `json:"payload"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateResourceInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Payload
+		src := firstPass.Payload
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal CreateResourceInput.Payload: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalCreateResourceInput struct {
+	Name string `json:"name"`
+
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func (v *CreateResourceInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateResourceInput) __premarshalJSON() (*__premarshalCreateResourceInput, error) {
+	var retval __premarshalCreateResourceInput
+
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Payload
+		src := v.Payload
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal CreateResourceInput.Payload: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
 // Cursor-based pagination input for list queries.
 //
 // Use `limit` to control page size and `next`/`previous` cursors to navigate between
@@ -123,6 +608,110 @@ func (v *Cursor) GetNext() string { return v.Next }
 // GetPrevious returns Cursor.Previous, and is useful for accessing the field via an interface.
 func (v *Cursor) GetPrevious() string { return v.Previous }
 
+// Attributes for the new environment. The fork references the parent via `parentId`, starts with blank instances, and does not copy any instance-level configuration. Use `copyInstance` per-instance if you want to seed configuration from the parent.
+type ForkEnvironmentInput struct {
+	// Key-value attributes for this environment. Keys and values must be strings. Must conform to the organization's custom attributes for the environment scope.
+	Attributes map[string]any `json:"-"`
+	// When true, copies the parent environment's default resource connections into the fork. Instance-level configuration is never copied — use `copyInstance` to seed instance params.
+	CopyEnvironmentDefaults bool `json:"copyEnvironmentDefaults,omitempty"`
+	// An optional description of the forked environment's purpose
+	Description string `json:"description,omitempty"`
+	// A short, memorable identifier for looking up this environment in the API and CLI. This becomes the second segment of instance identifiers. Max 20 characters, lowercase alphanumeric only (a-z, 0-9). Immutable after creation.
+	Id string `json:"id"`
+	// A human-readable name for the forked environment
+	Name string `json:"name"`
+}
+
+// GetAttributes returns ForkEnvironmentInput.Attributes, and is useful for accessing the field via an interface.
+func (v *ForkEnvironmentInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetCopyEnvironmentDefaults returns ForkEnvironmentInput.CopyEnvironmentDefaults, and is useful for accessing the field via an interface.
+func (v *ForkEnvironmentInput) GetCopyEnvironmentDefaults() bool { return v.CopyEnvironmentDefaults }
+
+// GetDescription returns ForkEnvironmentInput.Description, and is useful for accessing the field via an interface.
+func (v *ForkEnvironmentInput) GetDescription() string { return v.Description }
+
+// GetId returns ForkEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *ForkEnvironmentInput) GetId() string { return v.Id }
+
+// GetName returns ForkEnvironmentInput.Name, and is useful for accessing the field via an interface.
+func (v *ForkEnvironmentInput) GetName() string { return v.Name }
+
+func (v *ForkEnvironmentInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ForkEnvironmentInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ForkEnvironmentInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ForkEnvironmentInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalForkEnvironmentInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	CopyEnvironmentDefaults bool `json:"copyEnvironmentDefaults,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+}
+
+func (v *ForkEnvironmentInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ForkEnvironmentInput) __premarshalJSON() (*__premarshalForkEnvironmentInput, error) {
+	var retval __premarshalForkEnvironmentInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ForkEnvironmentInput.Attributes: %w", err)
+		}
+	}
+	retval.CopyEnvironmentDefaults = v.CopyEnvironmentDefaults
+	retval.Description = v.Description
+	retval.Id = v.Id
+	retval.Name = v.Name
+	return &retval, nil
+}
+
 // Filter by an identifier field.
 //
 // All operators within a single filter are combined with **AND**. To match any of
@@ -179,6 +768,73 @@ func (v *InstanceAlarmsFilter) GetInstanceId() *IdFilter { return v.InstanceId }
 // GetOciRepoName returns InstanceAlarmsFilter.OciRepoName, and is useful for accessing the field via an interface.
 func (v *InstanceAlarmsFilter) GetOciRepoName() *OciRepoNameFilter { return v.OciRepoName }
 
+// The current lifecycle state of an instance.
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+type InstanceStatus string
+
+const (
+	// The instance has been created but no deployment has started yet.
+	InstanceStatusInitialized InstanceStatus = "INITIALIZED"
+	// Infrastructure is successfully deployed and running.
+	InstanceStatusProvisioned InstanceStatus = "PROVISIONED"
+	// Infrastructure has been torn down. The instance record is retained for audit purposes.
+	InstanceStatusDecommissioned InstanceStatus = "DECOMMISSIONED"
+	// The most recent deployment failed. Check deployment logs for details. Can be retried.
+	InstanceStatusFailed InstanceStatus = "FAILED"
+)
+
+var AllInstanceStatus = []InstanceStatus{
+	InstanceStatusInitialized,
+	InstanceStatusProvisioned,
+	InstanceStatusDecommissioned,
+	InstanceStatusFailed,
+}
+
+// Create a link between two components in a project's blueprint. Links connect an output field on the source component to an input field on the destination component, establishing data flow between infrastructure resources.
+type LinkComponentsInput struct {
+	// ID of the component that produces the resource (e.g., 'myproj-database').
+	FromComponentId string `json:"fromComponentId"`
+	// Output field name on the source component
+	FromField string `json:"fromField"`
+	// Version constraint for the source component (e.g., '~1.0', '1.2.3', 'latest')
+	FromVersion string `json:"fromVersion"`
+	// ID of the component that consumes the resource (e.g., 'myproj-app').
+	ToComponentId string `json:"toComponentId"`
+	// Input field name on the destination component
+	ToField string `json:"toField"`
+	// Version constraint for the destination component (e.g., '~1.0', '1.2.3', 'latest')
+	ToVersion string `json:"toVersion"`
+}
+
+// GetFromComponentId returns LinkComponentsInput.FromComponentId, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromComponentId() string { return v.FromComponentId }
+
+// GetFromField returns LinkComponentsInput.FromField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromField() string { return v.FromField }
+
+// GetFromVersion returns LinkComponentsInput.FromVersion, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromVersion() string { return v.FromVersion }
+
+// GetToComponentId returns LinkComponentsInput.ToComponentId, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToComponentId() string { return v.ToComponentId }
+
+// GetToField returns LinkComponentsInput.ToField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToField() string { return v.ToField }
+
+// GetToVersion returns LinkComponentsInput.ToVersion, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToVersion() string { return v.ToVersion }
+
 // Filter by OCI repository name (the bundle's package identifier).
 //
 // Supports exact match, set membership, and prefix matching. Prefix matching is
@@ -209,830 +865,1173 @@ func (v *OciRepoNameFilter) GetIn() []string { return v.In }
 // GetStartsWith returns OciRepoNameFilter.StartsWith, and is useful for accessing the field via an interface.
 func (v *OciRepoNameFilter) GetStartsWith() string { return v.StartsWith }
 
-// Update a registered alarm's mutable fields. Omit a field to leave it unchanged.
-type UpdateInstanceAlarmInput struct {
-	// The cloud provider's unique identifier for the alarm. Updating this changes which incoming webhooks correlate to this alarm.
-	CloudResourceId string `json:"cloudResourceId,omitempty"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`).
-	ComparisonOperator string `json:"comparisonOperator,omitempty"`
-	// Human-readable name for the alarm. Shown in the UI and in notifications.
-	DisplayName string `json:"displayName,omitempty"`
-	// The cloud metric this alarm evaluates.
-	Metric *AlarmMetricInput `json:"metric,omitempty"`
-	// Evaluation window in seconds over which the metric is aggregated.
-	Period *int `json:"period,omitempty"`
-	// The value crossed to trigger the alarm.
-	Threshold *float64 `json:"threshold,omitempty"`
+// Upsert a resource type for your organization from a JSON Schema document. If an existing resource type has the same identifier, its schema is replaced. **Deprecated:** this mutation exists solely to bridge V0 `publishArtifactDefinition` into the V2 API while resource types are being migrated to OCI. New integrations should use the OCI-native publishing flow — this mutation may be removed without notice.
+type PublishResourceTypeInput struct {
+	// The full JSON Schema document describing the shape of data this resource type exposes to dependents. Must include `$md.name` (a kebab-case identifier like `aws-iam-role`) and should include `$md.label`, `$md.icon`, and `$md.ui.connectionOrientation`.
+	Schema map[string]any `json:"-"`
 }
 
-// GetCloudResourceId returns UpdateInstanceAlarmInput.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetCloudResourceId() string { return v.CloudResourceId }
+// GetSchema returns PublishResourceTypeInput.Schema, and is useful for accessing the field via an interface.
+func (v *PublishResourceTypeInput) GetSchema() map[string]any { return v.Schema }
 
-// GetComparisonOperator returns UpdateInstanceAlarmInput.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetComparisonOperator() string { return v.ComparisonOperator }
+func (v *PublishResourceTypeInput) UnmarshalJSON(b []byte) error {
 
-// GetDisplayName returns UpdateInstanceAlarmInput.DisplayName, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetDisplayName() string { return v.DisplayName }
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetMetric returns UpdateInstanceAlarmInput.Metric, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetMetric() *AlarmMetricInput { return v.Metric }
+	var firstPass struct {
+		*PublishResourceTypeInput
+		Schema json.RawMessage `json:"schema"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PublishResourceTypeInput = v
 
-// GetPeriod returns UpdateInstanceAlarmInput.Period, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetPeriod() *int { return v.Period }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetThreshold returns UpdateInstanceAlarmInput.Threshold, and is useful for accessing the field via an interface.
-func (v *UpdateInstanceAlarmInput) GetThreshold() *float64 { return v.Threshold }
+	{
+		dst := &v.Schema
+		src := firstPass.Schema
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal PublishResourceTypeInput.Schema: %w", err)
+			}
+		}
+	}
+	return nil
+}
 
-// __createInstanceAlarmInput is used internally by genqlient
-type __createInstanceAlarmInput struct {
-	OrganizationId string                   `json:"organizationId"`
-	InstanceId     string                   `json:"instanceId"`
-	Input          CreateInstanceAlarmInput `json:"input"`
+type __premarshalPublishResourceTypeInput struct {
+	Schema json.RawMessage `json:"schema"`
 }
 
-// GetOrganizationId returns __createInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__createInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+func (v *PublishResourceTypeInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// GetInstanceId returns __createInstanceAlarmInput.InstanceId, and is useful for accessing the field via an interface.
-func (v *__createInstanceAlarmInput) GetInstanceId() string { return v.InstanceId }
+func (v *PublishResourceTypeInput) __premarshalJSON() (*__premarshalPublishResourceTypeInput, error) {
+	var retval __premarshalPublishResourceTypeInput
 
-// GetInput returns __createInstanceAlarmInput.Input, and is useful for accessing the field via an interface.
-func (v *__createInstanceAlarmInput) GetInput() CreateInstanceAlarmInput { return v.Input }
+	{
 
-// __deleteInstanceAlarmInput is used internally by genqlient
-type __deleteInstanceAlarmInput struct {
-	OrganizationId string `json:"organizationId"`
-	Id             string `json:"id"`
+		dst := &retval.Schema
+		src := v.Schema
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal PublishResourceTypeInput.Schema: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetOrganizationId returns __deleteInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__deleteInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+// Controls which bundle releases are eligible for deployment.
+//
+// The release strategy works in conjunction with the version constraint to
+// determine which bundle version is resolved for deployment.
+type ReleaseStrategy string
+
+const (
+	// Only use stable, published releases. Recommended for production environments.
+	ReleaseStrategyStable ReleaseStrategy = "STABLE"
+	// Include pre-release/development builds. Useful for testing unreleased bundle changes.
+	ReleaseStrategyDevelopment ReleaseStrategy = "DEVELOPMENT"
+)
 
-// GetId returns __deleteInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
-func (v *__deleteInstanceAlarmInput) GetId() string { return v.Id }
+var AllReleaseStrategy = []ReleaseStrategy{
+	ReleaseStrategyStable,
+	ReleaseStrategyDevelopment,
+}
 
-// __getInstanceAlarmInput is used internally by genqlient
-type __getInstanceAlarmInput struct {
-	OrganizationId string `json:"organizationId"`
-	Id             string `json:"id"`
+// Remove a remote reference from an instance. The reference can only be removed if no provisioned instances are connected through it.
+type RemoveRemoteReferenceInput struct {
+	// The resource field to remove the reference from
+	Field string `json:"field"`
 }
 
-// GetOrganizationId returns __getInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__getInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+// GetField returns RemoveRemoteReferenceInput.Field, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceInput) GetField() string { return v.Field }
 
-// GetId returns __getInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
-func (v *__getInstanceAlarmInput) GetId() string { return v.Id }
+// How a resource was created, which determines how it can be managed.
+//
+// - **IMPORTED** resources are created and managed directly through the API.
+// You can update their name, payload, and delete them at any time.
+// - **PROVISIONED** resources are created automatically when an instance is deployed.
+// They are managed by their owning instance and cannot be modified or deleted through the API.
+type ResourceOrigin string
+
+const (
+	// Created manually via the API. Can be updated and deleted directly.
+	ResourceOriginImported ResourceOrigin = "IMPORTED"
+	// Created automatically by deploying an instance. Managed by the owning instance's lifecycle.
+	ResourceOriginProvisioned ResourceOrigin = "PROVISIONED"
+)
 
-// __listInstanceAlarmsInput is used internally by genqlient
-type __listInstanceAlarmsInput struct {
-	OrganizationId string                `json:"organizationId"`
-	Filter         *InstanceAlarmsFilter `json:"filter,omitempty"`
-	Cursor         *Cursor               `json:"cursor,omitempty"`
+var AllResourceOrigin = []ResourceOrigin{
+	ResourceOriginImported,
+	ResourceOriginProvisioned,
 }
 
-// GetOrganizationId returns __listInstanceAlarmsInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__listInstanceAlarmsInput) GetOrganizationId() string { return v.OrganizationId }
+// Filter resources by their origin.
+//
+// Provide either `eq` for an exact match or `in` for matching any of several origins.
+type ResourceOriginFilter struct {
+	// Return resources with exactly this origin.
+	Eq ResourceOrigin `json:"eq,omitempty"`
+	// Return resources matching any of the listed origins.
+	In []ResourceOrigin `json:"in,omitempty"`
+}
 
-// GetFilter returns __listInstanceAlarmsInput.Filter, and is useful for accessing the field via an interface.
-func (v *__listInstanceAlarmsInput) GetFilter() *InstanceAlarmsFilter { return v.Filter }
+// GetEq returns ResourceOriginFilter.Eq, and is useful for accessing the field via an interface.
+func (v *ResourceOriginFilter) GetEq() ResourceOrigin { return v.Eq }
 
-// GetCursor returns __listInstanceAlarmsInput.Cursor, and is useful for accessing the field via an interface.
-func (v *__listInstanceAlarmsInput) GetCursor() *Cursor { return v.Cursor }
+// GetIn returns ResourceOriginFilter.In, and is useful for accessing the field via an interface.
+func (v *ResourceOriginFilter) GetIn() []ResourceOrigin { return v.In }
 
-// __updateInstanceAlarmInput is used internally by genqlient
-type __updateInstanceAlarmInput struct {
-	OrganizationId string                   `json:"organizationId"`
-	Id             string                   `json:"id"`
-	Input          UpdateInstanceAlarmInput `json:"input"`
+// Narrows the resources list to only matching records.
+//
+// All filters are combined with AND logic. Omit a filter to skip that criterion.
+type ResourcesFilter struct {
+	// Return only resources with the specified origin (IMPORTED or PROVISIONED).
+	Origin *ResourceOriginFilter `json:"origin,omitempty"`
+	// Return only resources of the given resource type, matched by the type's identifier (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	ResourceType *StringFilter `json:"resourceType,omitempty"`
+	// Return only resources provisioned into the specified environment(s). Imported resources have no environment and are excluded when this filter is set.
+	EnvironmentId *IdFilter `json:"environmentId,omitempty"`
+	// Full-text search across the resource name. Results are ranked by relevance unless you provide an explicit `sort`. For terms longer than 3 characters, name-prefix matches are also included. **Note:** pagination cursors returned by search results use offset-based pagination and are not interchangeable with cursors from non-search queries.
+	Search string `json:"search,omitempty"`
 }
 
-// GetOrganizationId returns __updateInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
-func (v *__updateInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+// GetOrigin returns ResourcesFilter.Origin, and is useful for accessing the field via an interface.
+func (v *ResourcesFilter) GetOrigin() *ResourceOriginFilter { return v.Origin }
 
-// GetId returns __updateInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateInstanceAlarmInput) GetId() string { return v.Id }
+// GetResourceType returns ResourcesFilter.ResourceType, and is useful for accessing the field via an interface.
+func (v *ResourcesFilter) GetResourceType() *StringFilter { return v.ResourceType }
 
-// GetInput returns __updateInstanceAlarmInput.Input, and is useful for accessing the field via an interface.
-func (v *__updateInstanceAlarmInput) GetInput() UpdateInstanceAlarmInput { return v.Input }
+// GetEnvironmentId returns ResourcesFilter.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *ResourcesFilter) GetEnvironmentId() *IdFilter { return v.EnvironmentId }
 
-// createInstanceAlarmCreateInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
-type createInstanceAlarmCreateInstanceAlarmAlarmPayload struct {
-	// The object created/updated/deleted by the mutation. May be null if mutation failed.
-	Result createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
-	// Indicates if the mutation completed successfully or not.
-	Successful bool `json:"successful"`
-	// A list of failed validations. May be blank or null if mutation succeeded.
-	Messages []createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
-}
+// GetSearch returns ResourcesFilter.Search, and is useful for accessing the field via an interface.
+func (v *ResourcesFilter) GetSearch() string { return v.Search }
 
-// GetResult returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetResult() createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm {
-	return v.Result
+// Set the position of a component on the canvas.
+type SetComponentPositionInput struct {
+	// Horizontal position in pixels
+	X int `json:"x"`
+	// Vertical position in pixels
+	Y int `json:"y"`
 }
 
-// GetSuccessful returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetSuccessful() bool {
-	return v.Successful
-}
+// GetX returns SetComponentPositionInput.X, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionInput) GetX() int { return v.X }
 
-// GetMessages returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetMessages() []createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage {
-	return v.Messages
+// GetY returns SetComponentPositionInput.Y, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionInput) GetY() int { return v.Y }
+
+// Link an instance's resource field to a resource from another project or an imported resource. The instance must not be in a provisioned or failed state.
+type SetRemoteReferenceInput struct {
+	// The resource field to assign the reference to
+	Field string `json:"field"`
 }
 
-// createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
-// The GraphQL type's documentation follows.
-//
-// Validation messages are returned when mutation input does not meet the requirements.
-// While client-side validation is highly recommended to provide the best User Experience,
-// All inputs will always be validated server-side.
+// GetField returns SetRemoteReferenceInput.Field, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceInput) GetField() string { return v.Field }
+
+// Filter by a string field.
 //
-// Some examples of validations are:
+// All operators within a single filter are combined with **AND**. To match any of
+// several values, use the `in` operator rather than multiple `eq` filters.
 //
-// * Username must be at least 10 characters
-// * Email field does not contain an email address
-// * Birth Date is required
+// ```graphql
+// # Exact match
+// { "name": { "eq": "production" } }
 //
-// While GraphQL has support for required values, mutation data fields are always
-// set to optional in our API. This allows 'required field' messages
-// to be returned in the same manner as other validations. The only exceptions
-// are id fields, which may be required to perform updates or deletes.
-type createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
-	// A unique error code for the type of validation used.
-	Code string `json:"code"`
-	// The input field that the error applies to. The field can be used to
-	// identify which field the error message should be displayed next to in the
-	// presentation layer.
-	//
-	// If there are multiple errors to display for a field, multiple validation
-	// messages will be in the result.
-	//
-	// This field may be null in cases where an error cannot be applied to a specific field.
-	Field string `json:"field"`
-	// A friendly error message, appropriate for display to the end user.
-	//
-	// The message is interpolated to include the appropriate variables.
-	//
-	// Example: `Username must be at least 10 characters`
-	//
-	// This message may change without notice, so we do not recommend you match against the text.
-	// Instead, use the *code* field for matching.
-	Message string `json:"message"`
+// # Match several values (logical OR between values)
+// { "name": { "in": ["production", "staging"] } }
+// ```
+type StringFilter struct {
+	// Return only results whose value exactly equals this string.
+	Eq string `json:"eq,omitempty"`
+	// Return results whose value matches any string in this list.
+	In []string `json:"in,omitempty"`
 }
 
-// GetCode returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
-	return v.Code
-}
+// GetEq returns StringFilter.Eq, and is useful for accessing the field via an interface.
+func (v *StringFilter) GetEq() string { return v.Eq }
 
-// GetField returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
-	return v.Field
-}
+// GetIn returns StringFilter.In, and is useful for accessing the field via an interface.
+func (v *StringFilter) GetIn() []string { return v.In }
 
-// GetMessage returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
-	return v.Message
+// Update an existing component's name, description, and attributes. The component ID and underlying bundle cannot be changed.
+type UpdateComponentInput struct {
+	// Key-value attributes for this component. Keys and values must be strings. Must conform to the organization's custom attributes for the component scope.
+	Attributes map[string]any `json:"-"`
+	// Optional description of this component's purpose
+	Description string `json:"description"`
+	// Display name for this component (e.g., 'Billing Database')
+	Name string `json:"name"`
 }
 
-// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
-// The GraphQL type's documentation follows.
-//
-// A cloud metric alarm attached to an instance.
-//
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
-//
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm struct {
-	// Unique identifier for this alarm.
-	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
-	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
-	CloudResourceId string `json:"cloudResourceId"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
-	ComparisonOperator string `json:"comparisonOperator"`
-	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
-	Threshold float64 `json:"threshold"`
-	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
-	Period int `json:"period"`
-	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
-	Metric *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric `json:"metric"`
-}
+// GetAttributes returns UpdateComponentInput.Attributes, and is useful for accessing the field via an interface.
+func (v *UpdateComponentInput) GetAttributes() map[string]any { return v.Attributes }
 
-// GetId returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+// GetDescription returns UpdateComponentInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateComponentInput) GetDescription() string { return v.Description }
 
-// GetDisplayName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
-	return v.DisplayName
-}
+// GetName returns UpdateComponentInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateComponentInput) GetName() string { return v.Name }
 
-// GetCloudResourceId returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetCloudResourceId() string {
-	return v.CloudResourceId
-}
+func (v *UpdateComponentInput) UnmarshalJSON(b []byte) error {
 
-// GetComparisonOperator returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetComparisonOperator() string {
-	return v.ComparisonOperator
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetThreshold returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Threshold, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetThreshold() float64 {
-	return v.Threshold
-}
+	var firstPass struct {
+		*UpdateComponentInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateComponentInput = v
 
-// GetPeriod returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Period, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetPeriod() int {
-	return v.Period
-}
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetMetric returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Metric, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetMetric() *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric {
-	return v.Metric
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UpdateComponentInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
-// The GraphQL type's documentation follows.
-//
-// The cloud metric an alarm is evaluating.
-//
-// Shape and populated fields vary by provider. AWS and Azure populate
-// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
-// are populated when the provider exposes them as structured key-value pairs.
-type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric struct {
-	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
-	Namespace string `json:"namespace"`
-	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+type __premarshalUpdateComponentInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description"`
+
 	Name string `json:"name"`
-	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
-	Statistic string `json:"statistic"`
-	// Cloud region this metric is scoped to, when provider-reported.
-	Region string `json:"region"`
-	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
-	Dimensions []createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
 }
 
-// GetNamespace returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Namespace, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetNamespace() string {
-	return v.Namespace
+func (v *UpdateComponentInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Name, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetName() string {
-	return v.Name
-}
+func (v *UpdateComponentInput) __premarshalJSON() (*__premarshalUpdateComponentInput, error) {
+	var retval __premarshalUpdateComponentInput
 
-// GetStatistic returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Statistic, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetStatistic() string {
-	return v.Statistic
-}
+	{
 
-// GetRegion returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Region, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetRegion() string {
-	return v.Region
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UpdateComponentInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Name = v.Name
+	return &retval, nil
 }
 
-// GetDimensions returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetDimensions() []createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension {
-	return v.Dimensions
+// Update an existing environment's name and description. The ID cannot be changed after creation.
+type UpdateEnvironmentInput struct {
+	// Key-value attributes for this environment. Keys and values must be strings. Must conform to the organization's custom attributes for the environment scope.
+	Attributes map[string]any `json:"-"`
+	// An optional description of the environment's purpose
+	Description string `json:"description"`
+	// A human-readable name for the environment
+	Name string `json:"name"`
 }
 
-// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
-// The GraphQL type's documentation follows.
-//
-// A key-value pair identifying the specific cloud resource a metric applies to.
-//
-// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
-// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
-type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension struct {
-	// Dimension name as defined by the cloud provider.
-	Name string `json:"name"`
-	// Dimension value identifying the monitored resource.
-	Value string `json:"value"`
-}
+// GetAttributes returns UpdateEnvironmentInput.Attributes, and is useful for accessing the field via an interface.
+func (v *UpdateEnvironmentInput) GetAttributes() map[string]any { return v.Attributes }
 
-// GetName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
-	return v.Name
-}
+// GetDescription returns UpdateEnvironmentInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateEnvironmentInput) GetDescription() string { return v.Description }
 
-// GetValue returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
-	return v.Value
+// GetName returns UpdateEnvironmentInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateEnvironmentInput) GetName() string { return v.Name }
+
+func (v *UpdateEnvironmentInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateEnvironmentInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateEnvironmentInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UpdateEnvironmentInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// createInstanceAlarmResponse is returned by createInstanceAlarm on success.
-type createInstanceAlarmResponse struct {
-	// Register a cloud metric alarm with an instance.
-	//
-	// The alarm appears in the UI immediately and starts receiving state updates
-	// as soon as the cloud provider reports them. The `cloudResourceId` is used
-	// to correlate inbound webhooks (CloudWatch, Azure Monitor, GCP Cloud
-	// Monitoring, Alertmanager) back to this alarm — it must be unique within
-	// the instance.
-	//
-	// Requires `environment:update` on the alarm's environment.
-	CreateInstanceAlarm createInstanceAlarmCreateInstanceAlarmAlarmPayload `json:"createInstanceAlarm"`
+type __premarshalUpdateEnvironmentInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description"`
+
+	Name string `json:"name"`
 }
 
-// GetCreateInstanceAlarm returns createInstanceAlarmResponse.CreateInstanceAlarm, and is useful for accessing the field via an interface.
-func (v *createInstanceAlarmResponse) GetCreateInstanceAlarm() createInstanceAlarmCreateInstanceAlarmAlarmPayload {
-	return v.CreateInstanceAlarm
+func (v *UpdateEnvironmentInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
-type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload struct {
-	// The object created/updated/deleted by the mutation. May be null if mutation failed.
-	Result deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
-	// Indicates if the mutation completed successfully or not.
-	Successful bool `json:"successful"`
-	// A list of failed validations. May be blank or null if mutation succeeded.
-	Messages []deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
+func (v *UpdateEnvironmentInput) __premarshalJSON() (*__premarshalUpdateEnvironmentInput, error) {
+	var retval __premarshalUpdateEnvironmentInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UpdateEnvironmentInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Name = v.Name
+	return &retval, nil
 }
 
-// GetResult returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetResult() deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm {
-	return v.Result
+// Update a registered alarm's mutable fields. Omit a field to leave it unchanged.
+type UpdateInstanceAlarmInput struct {
+	// The cloud provider's unique identifier for the alarm. Updating this changes which incoming webhooks correlate to this alarm.
+	CloudResourceId string `json:"cloudResourceId,omitempty"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`).
+	ComparisonOperator string `json:"comparisonOperator,omitempty"`
+	// Human-readable name for the alarm. Shown in the UI and in notifications.
+	DisplayName string `json:"displayName,omitempty"`
+	// The cloud metric this alarm evaluates.
+	Metric *AlarmMetricInput `json:"metric,omitempty"`
+	// Evaluation window in seconds over which the metric is aggregated.
+	Period *int `json:"period,omitempty"`
+	// The value crossed to trigger the alarm.
+	Threshold *float64 `json:"threshold,omitempty"`
 }
 
-// GetSuccessful returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetSuccessful() bool {
-	return v.Successful
+// GetCloudResourceId returns UpdateInstanceAlarmInput.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetCloudResourceId() string { return v.CloudResourceId }
+
+// GetComparisonOperator returns UpdateInstanceAlarmInput.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetComparisonOperator() string { return v.ComparisonOperator }
+
+// GetDisplayName returns UpdateInstanceAlarmInput.DisplayName, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetDisplayName() string { return v.DisplayName }
+
+// GetMetric returns UpdateInstanceAlarmInput.Metric, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetMetric() *AlarmMetricInput { return v.Metric }
+
+// GetPeriod returns UpdateInstanceAlarmInput.Period, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetPeriod() *int { return v.Period }
+
+// GetThreshold returns UpdateInstanceAlarmInput.Threshold, and is useful for accessing the field via an interface.
+func (v *UpdateInstanceAlarmInput) GetThreshold() *float64 { return v.Threshold }
+
+// Update an existing project's name and description. The ID cannot be changed after creation.
+type UpdateProjectInput struct {
+	// Key-value attributes for this project. Keys and values must be strings. Must conform to the organization's custom attributes for the project scope.
+	Attributes map[string]any `json:"-"`
+	// An optional description of the project's purpose or contents
+	Description string `json:"description"`
+	// A human-readable name for the project
+	Name string `json:"name"`
 }
 
-// GetMessages returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetMessages() []deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage {
-	return v.Messages
+// GetAttributes returns UpdateProjectInput.Attributes, and is useful for accessing the field via an interface.
+func (v *UpdateProjectInput) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns UpdateProjectInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateProjectInput) GetDescription() string { return v.Description }
+
+// GetName returns UpdateProjectInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateProjectInput) GetName() string { return v.Name }
+
+func (v *UpdateProjectInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateProjectInput
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateProjectInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UpdateProjectInput.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
-// The GraphQL type's documentation follows.
-//
-// Validation messages are returned when mutation input does not meet the requirements.
-// While client-side validation is highly recommended to provide the best User Experience,
-// All inputs will always be validated server-side.
-//
-// Some examples of validations are:
-//
-// * Username must be at least 10 characters
-// * Email field does not contain an email address
-// * Birth Date is required
-//
-// While GraphQL has support for required values, mutation data fields are always
-// set to optional in our API. This allows 'required field' messages
-// to be returned in the same manner as other validations. The only exceptions
-// are id fields, which may be required to perform updates or deletes.
-type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
-	// A unique error code for the type of validation used.
-	Code string `json:"code"`
-	// The input field that the error applies to. The field can be used to
-	// identify which field the error message should be displayed next to in the
-	// presentation layer.
-	//
-	// If there are multiple errors to display for a field, multiple validation
-	// messages will be in the result.
-	//
-	// This field may be null in cases where an error cannot be applied to a specific field.
-	Field string `json:"field"`
-	// A friendly error message, appropriate for display to the end user.
-	//
-	// The message is interpolated to include the appropriate variables.
-	//
-	// Example: `Username must be at least 10 characters`
-	//
-	// This message may change without notice, so we do not recommend you match against the text.
-	// Instead, use the *code* field for matching.
-	Message string `json:"message"`
+type __premarshalUpdateProjectInput struct {
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	Description string `json:"description"`
+
+	Name string `json:"name"`
 }
 
-// GetCode returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
-	return v.Code
+func (v *UpdateProjectInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetField returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
-	return v.Field
+func (v *UpdateProjectInput) __premarshalJSON() (*__premarshalUpdateProjectInput, error) {
+	var retval __premarshalUpdateProjectInput
+
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UpdateProjectInput.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Name = v.Name
+	return &retval, nil
 }
 
-// GetMessage returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
-	return v.Message
+// Update a resource's name or payload. Provisioned resources can only have their name updated. Imported resources can also update their payload.
+type UpdateResourceInput struct {
+	// A new human-readable name for this resource
+	Name string `json:"name"`
+	// Updated resource data. Only applicable to imported resources.
+	Payload map[string]any `json:"-"`
 }
 
-// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
-// The GraphQL type's documentation follows.
-//
-// A cloud metric alarm attached to an instance.
-//
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
-//
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm struct {
-	// Unique identifier for this alarm.
-	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
+// GetName returns UpdateResourceInput.Name, and is useful for accessing the field via an interface.
+func (v *UpdateResourceInput) GetName() string { return v.Name }
+
+// GetPayload returns UpdateResourceInput.Payload, and is useful for accessing the field via an interface.
+func (v *UpdateResourceInput) GetPayload() map[string]any { return v.Payload }
+
+func (v *UpdateResourceInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateResourceInput
+		Payload json.RawMessage `json:"payload"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateResourceInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Payload
+		src := firstPass.Payload
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal UpdateResourceInput.Payload: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetId returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+type __premarshalUpdateResourceInput struct {
+	Name string `json:"name"`
 
-// GetDisplayName returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
-	return v.DisplayName
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
-// deleteInstanceAlarmResponse is returned by deleteInstanceAlarm on success.
-type deleteInstanceAlarmResponse struct {
-	// Delete an alarm registration.
-	//
-	// Removes the alarm and any recorded state transitions. The underlying cloud
-	// provider alarm is unaffected.
-	//
-	// Requires `environment:update` on the alarm's environment.
-	DeleteInstanceAlarm deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload `json:"deleteInstanceAlarm"`
+func (v *UpdateResourceInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetDeleteInstanceAlarm returns deleteInstanceAlarmResponse.DeleteInstanceAlarm, and is useful for accessing the field via an interface.
-func (v *deleteInstanceAlarmResponse) GetDeleteInstanceAlarm() deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload {
-	return v.DeleteInstanceAlarm
+func (v *UpdateResourceInput) __premarshalJSON() (*__premarshalUpdateResourceInput, error) {
+	var retval __premarshalUpdateResourceInput
+
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Payload
+		src := v.Payload
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal UpdateResourceInput.Payload: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// getInstanceAlarmInstanceAlarm includes the requested fields of the GraphQL type Alarm.
-// The GraphQL type's documentation follows.
-//
-// A cloud metric alarm attached to an instance.
-//
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
-//
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type getInstanceAlarmInstanceAlarm struct {
-	// Unique identifier for this alarm.
-	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
-	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
-	CloudResourceId string `json:"cloudResourceId"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
-	ComparisonOperator string `json:"comparisonOperator"`
-	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
-	Threshold float64 `json:"threshold"`
-	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
-	Period int `json:"period"`
-	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
-	Metric *getInstanceAlarmInstanceAlarmMetric `json:"metric"`
+// __addComponentInput is used internally by genqlient
+type __addComponentInput struct {
+	OrganizationId string            `json:"organizationId"`
+	ProjectId      string            `json:"projectId"`
+	OciRepoName    string            `json:"ociRepoName"`
+	Input          AddComponentInput `json:"input"`
 }
 
-// GetId returns getInstanceAlarmInstanceAlarm.Id, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetId() string { return v.Id }
+// GetOrganizationId returns __addComponentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__addComponentInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetDisplayName returns getInstanceAlarmInstanceAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetDisplayName() string { return v.DisplayName }
+// GetProjectId returns __addComponentInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__addComponentInput) GetProjectId() string { return v.ProjectId }
 
-// GetCloudResourceId returns getInstanceAlarmInstanceAlarm.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetCloudResourceId() string { return v.CloudResourceId }
+// GetOciRepoName returns __addComponentInput.OciRepoName, and is useful for accessing the field via an interface.
+func (v *__addComponentInput) GetOciRepoName() string { return v.OciRepoName }
 
-// GetComparisonOperator returns getInstanceAlarmInstanceAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetComparisonOperator() string { return v.ComparisonOperator }
+// GetInput returns __addComponentInput.Input, and is useful for accessing the field via an interface.
+func (v *__addComponentInput) GetInput() AddComponentInput { return v.Input }
 
-// GetThreshold returns getInstanceAlarmInstanceAlarm.Threshold, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetThreshold() float64 { return v.Threshold }
+// __cloneProjectInput is used internally by genqlient
+type __cloneProjectInput struct {
+	OrganizationId  string            `json:"organizationId"`
+	SourceProjectId string            `json:"sourceProjectId"`
+	Input           CloneProjectInput `json:"input"`
+}
 
-// GetPeriod returns getInstanceAlarmInstanceAlarm.Period, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetPeriod() int { return v.Period }
+// GetOrganizationId returns __cloneProjectInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__cloneProjectInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetMetric returns getInstanceAlarmInstanceAlarm.Metric, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarm) GetMetric() *getInstanceAlarmInstanceAlarmMetric {
-	return v.Metric
+// GetSourceProjectId returns __cloneProjectInput.SourceProjectId, and is useful for accessing the field via an interface.
+func (v *__cloneProjectInput) GetSourceProjectId() string { return v.SourceProjectId }
+
+// GetInput returns __cloneProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__cloneProjectInput) GetInput() CloneProjectInput { return v.Input }
+
+// __createEnvironmentInput is used internally by genqlient
+type __createEnvironmentInput struct {
+	OrganizationId string                 `json:"organizationId"`
+	ProjectId      string                 `json:"projectId"`
+	Input          CreateEnvironmentInput `json:"input"`
 }
 
-// getInstanceAlarmInstanceAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
-// The GraphQL type's documentation follows.
-//
-// The cloud metric an alarm is evaluating.
-//
-// Shape and populated fields vary by provider. AWS and Azure populate
-// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
-// are populated when the provider exposes them as structured key-value pairs.
-type getInstanceAlarmInstanceAlarmMetric struct {
-	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
-	Namespace string `json:"namespace"`
-	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
-	Name string `json:"name"`
-	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
-	Statistic string `json:"statistic"`
-	// Cloud region this metric is scoped to, when provider-reported.
-	Region string `json:"region"`
-	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
-	Dimensions []getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
-}
+// GetOrganizationId returns __createEnvironmentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__createEnvironmentInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetNamespace returns getInstanceAlarmInstanceAlarmMetric.Namespace, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetric) GetNamespace() string { return v.Namespace }
+// GetProjectId returns __createEnvironmentInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__createEnvironmentInput) GetProjectId() string { return v.ProjectId }
 
-// GetName returns getInstanceAlarmInstanceAlarmMetric.Name, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetric) GetName() string { return v.Name }
+// GetInput returns __createEnvironmentInput.Input, and is useful for accessing the field via an interface.
+func (v *__createEnvironmentInput) GetInput() CreateEnvironmentInput { return v.Input }
 
-// GetStatistic returns getInstanceAlarmInstanceAlarmMetric.Statistic, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetric) GetStatistic() string { return v.Statistic }
+// __createInstanceAlarmInput is used internally by genqlient
+type __createInstanceAlarmInput struct {
+	OrganizationId string                   `json:"organizationId"`
+	InstanceId     string                   `json:"instanceId"`
+	Input          CreateInstanceAlarmInput `json:"input"`
+}
 
-// GetRegion returns getInstanceAlarmInstanceAlarmMetric.Region, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetric) GetRegion() string { return v.Region }
+// GetOrganizationId returns __createInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__createInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetDimensions returns getInstanceAlarmInstanceAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetric) GetDimensions() []getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension {
-	return v.Dimensions
-}
+// GetInstanceId returns __createInstanceAlarmInput.InstanceId, and is useful for accessing the field via an interface.
+func (v *__createInstanceAlarmInput) GetInstanceId() string { return v.InstanceId }
 
-// getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
-// The GraphQL type's documentation follows.
-//
-// A key-value pair identifying the specific cloud resource a metric applies to.
-//
-// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
-// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
-type getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension struct {
-	// Dimension name as defined by the cloud provider.
-	Name string `json:"name"`
-	// Dimension value identifying the monitored resource.
-	Value string `json:"value"`
-}
+// GetInput returns __createInstanceAlarmInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInstanceAlarmInput) GetInput() CreateInstanceAlarmInput { return v.Input }
 
-// GetName returns getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
-	return v.Name
+// __createProjectInput is used internally by genqlient
+type __createProjectInput struct {
+	OrganizationId string             `json:"organizationId"`
+	Input          CreateProjectInput `json:"input"`
 }
 
-// GetValue returns getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
-	return v.Value
-}
+// GetOrganizationId returns __createProjectInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__createProjectInput) GetOrganizationId() string { return v.OrganizationId }
 
-// getInstanceAlarmResponse is returned by getInstanceAlarm on success.
-type getInstanceAlarmResponse struct {
-	// Fetch a single alarm by its unique identifier.
-	//
-	// Returns the alarm with its most recent `currentState` attached, or a
-	// `NOT_FOUND` error if the alarm doesn't exist or its project isn't
-	// visible to the caller.
-	//
-	// ```graphql
-	// query {
-	// instanceAlarm(organizationId: "my-org", id: "0192…uuid") {
-	// id
-	// displayName
-	// currentState { status occurredAt }
-	// metric { namespace name }
-	// }
-	// }
-	// ```
-	InstanceAlarm getInstanceAlarmInstanceAlarm `json:"instanceAlarm"`
-}
+// GetInput returns __createProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectInput) GetInput() CreateProjectInput { return v.Input }
 
-// GetInstanceAlarm returns getInstanceAlarmResponse.InstanceAlarm, and is useful for accessing the field via an interface.
-func (v *getInstanceAlarmResponse) GetInstanceAlarm() getInstanceAlarmInstanceAlarm {
-	return v.InstanceAlarm
+// __createResourceInput is used internally by genqlient
+type __createResourceInput struct {
+	OrganizationId string              `json:"organizationId"`
+	ResourceTypeId string              `json:"resourceTypeId"`
+	Input          CreateResourceInput `json:"input"`
 }
 
-// listInstanceAlarmsInstanceAlarmsAlarmsPage includes the requested fields of the GraphQL type AlarmsPage.
-type listInstanceAlarmsInstanceAlarmsAlarmsPage struct {
-	// Pagination cursors for navigating between pages.
-	Cursor listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor `json:"cursor"`
-	// A list of type alarm.
-	Items []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm `json:"items"`
-}
+// GetOrganizationId returns __createResourceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__createResourceInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetCursor returns listInstanceAlarmsInstanceAlarmsAlarmsPage.Cursor, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPage) GetCursor() listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor {
-	return v.Cursor
-}
+// GetResourceTypeId returns __createResourceInput.ResourceTypeId, and is useful for accessing the field via an interface.
+func (v *__createResourceInput) GetResourceTypeId() string { return v.ResourceTypeId }
 
-// GetItems returns listInstanceAlarmsInstanceAlarmsAlarmsPage.Items, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPage) GetItems() []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm {
-	return v.Items
-}
+// GetInput returns __createResourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__createResourceInput) GetInput() CreateResourceInput { return v.Input }
 
-// listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
-// The GraphQL type's documentation follows.
-//
-// Pagination cursors returned with every paginated response.
-//
-// Contains opaque cursor strings for navigating forward and backward through results.
-// A `null` value for `next` indicates you have reached the last page; a `null` value
-// for `previous` indicates you are on the first page.
-type listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor struct {
-	// Cursor for the next page. `null` if there are no more results.
-	Next string `json:"next"`
+// __deleteEnvironmentInput is used internally by genqlient
+type __deleteEnvironmentInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetNext returns listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor) GetNext() string {
-	return v.Next
-}
+// GetOrganizationId returns __deleteEnvironmentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__deleteEnvironmentInput) GetOrganizationId() string { return v.OrganizationId }
 
-// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm includes the requested fields of the GraphQL type Alarm.
-// The GraphQL type's documentation follows.
-//
-// A cloud metric alarm attached to an instance.
-//
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
-//
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm struct {
-	// Unique identifier for this alarm.
-	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
-	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
-	CloudResourceId string `json:"cloudResourceId"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
-	ComparisonOperator string `json:"comparisonOperator"`
-	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
-	Threshold float64 `json:"threshold"`
-	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
-	Period int `json:"period"`
-	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
-	Metric *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric `json:"metric"`
+// GetId returns __deleteEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteEnvironmentInput) GetId() string { return v.Id }
+
+// __deleteInstanceAlarmInput is used internally by genqlient
+type __deleteInstanceAlarmInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetId returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Id, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetId() string { return v.Id }
+// GetOrganizationId returns __deleteInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__deleteInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetDisplayName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetDisplayName() string {
-	return v.DisplayName
-}
+// GetId returns __deleteInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInstanceAlarmInput) GetId() string { return v.Id }
 
-// GetCloudResourceId returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetCloudResourceId() string {
-	return v.CloudResourceId
+// __deleteProjectInput is used internally by genqlient
+type __deleteProjectInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetComparisonOperator returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetComparisonOperator() string {
-	return v.ComparisonOperator
-}
+// GetOrganizationId returns __deleteProjectInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__deleteProjectInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetThreshold returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Threshold, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetThreshold() float64 {
-	return v.Threshold
+// GetId returns __deleteProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectInput) GetId() string { return v.Id }
+
+// __deleteResourceInput is used internally by genqlient
+type __deleteResourceInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetPeriod returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Period, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetPeriod() int { return v.Period }
+// GetOrganizationId returns __deleteResourceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__deleteResourceInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetMetric returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Metric, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetMetric() *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric {
-	return v.Metric
-}
+// GetId returns __deleteResourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteResourceInput) GetId() string { return v.Id }
 
-// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
-// The GraphQL type's documentation follows.
-//
-// The cloud metric an alarm is evaluating.
-//
-// Shape and populated fields vary by provider. AWS and Azure populate
-// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
-// are populated when the provider exposes them as structured key-value pairs.
-type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric struct {
-	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
-	Namespace string `json:"namespace"`
-	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
-	Name string `json:"name"`
-	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
-	Statistic string `json:"statistic"`
-	// Cloud region this metric is scoped to, when provider-reported.
-	Region string `json:"region"`
-	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
-	Dimensions []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+// __deleteResourceTypeInput is used internally by genqlient
+type __deleteResourceTypeInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetNamespace returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Namespace, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetNamespace() string {
-	return v.Namespace
-}
+// GetOrganizationId returns __deleteResourceTypeInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__deleteResourceTypeInput) GetOrganizationId() string { return v.OrganizationId }
 
-// GetName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Name, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetName() string { return v.Name }
+// GetId returns __deleteResourceTypeInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteResourceTypeInput) GetId() string { return v.Id }
 
-// GetStatistic returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Statistic, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetStatistic() string {
-	return v.Statistic
+// __exportResourceInput is used internally by genqlient
+type __exportResourceInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+	Format         string `json:"format,omitempty"`
 }
 
-// GetRegion returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Region, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetRegion() string {
-	return v.Region
+// GetOrganizationId returns __exportResourceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__exportResourceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __exportResourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__exportResourceInput) GetId() string { return v.Id }
+
+// GetFormat returns __exportResourceInput.Format, and is useful for accessing the field via an interface.
+func (v *__exportResourceInput) GetFormat() string { return v.Format }
+
+// __forkEnvironmentInput is used internally by genqlient
+type __forkEnvironmentInput struct {
+	OrganizationId string               `json:"organizationId"`
+	ParentId       string               `json:"parentId"`
+	Input          ForkEnvironmentInput `json:"input"`
 }
 
-// GetDimensions returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetDimensions() []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension {
-	return v.Dimensions
+// GetOrganizationId returns __forkEnvironmentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__forkEnvironmentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetParentId returns __forkEnvironmentInput.ParentId, and is useful for accessing the field via an interface.
+func (v *__forkEnvironmentInput) GetParentId() string { return v.ParentId }
+
+// GetInput returns __forkEnvironmentInput.Input, and is useful for accessing the field via an interface.
+func (v *__forkEnvironmentInput) GetInput() ForkEnvironmentInput { return v.Input }
+
+// __getComponentInput is used internally by genqlient
+type __getComponentInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
-// The GraphQL type's documentation follows.
-//
-// A key-value pair identifying the specific cloud resource a metric applies to.
-//
-// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
-// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
-type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension struct {
-	// Dimension name as defined by the cloud provider.
-	Name string `json:"name"`
-	// Dimension value identifying the monitored resource.
-	Value string `json:"value"`
+// GetOrganizationId returns __getComponentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getComponentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getComponentInput) GetId() string { return v.Id }
+
+// __getComponentLinksInput is used internally by genqlient
+type __getComponentLinksInput struct {
+	OrganizationId string  `json:"organizationId"`
+	ComponentId    string  `json:"componentId"`
+	Cursor         *Cursor `json:"cursor,omitempty"`
 }
 
-// GetName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
-	return v.Name
+// GetOrganizationId returns __getComponentLinksInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getComponentLinksInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetComponentId returns __getComponentLinksInput.ComponentId, and is useful for accessing the field via an interface.
+func (v *__getComponentLinksInput) GetComponentId() string { return v.ComponentId }
+
+// GetCursor returns __getComponentLinksInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getComponentLinksInput) GetCursor() *Cursor { return v.Cursor }
+
+// __getEnvironmentDefaultsInput is used internally by genqlient
+type __getEnvironmentDefaultsInput struct {
+	OrganizationId string  `json:"organizationId"`
+	EnvironmentId  string  `json:"environmentId"`
+	Cursor         *Cursor `json:"cursor,omitempty"`
 }
 
-// GetValue returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
-	return v.Value
+// GetOrganizationId returns __getEnvironmentDefaultsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentDefaultsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetEnvironmentId returns __getEnvironmentDefaultsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentDefaultsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetCursor returns __getEnvironmentDefaultsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentDefaultsInput) GetCursor() *Cursor { return v.Cursor }
+
+// __getEnvironmentInput is used internally by genqlient
+type __getEnvironmentInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// listInstanceAlarmsResponse is returned by listInstanceAlarms on success.
-type listInstanceAlarmsResponse struct {
-	// List alarms across all projects you can see.
-	//
-	// Returns a paginated list. Use `filter` to narrow by project, environment,
-	// component, bundle (`ociRepoName`), or instance. Default sort is
-	// alphabetical by `displayName`.
-	//
-	// ```graphql
-	// query {
-	// instanceAlarms(
-	// organizationId: "my-org"
-	// filter: { environmentId: { eq: "prod" }, ociRepoName: { eq: "aws-rds" } }
-	// ) {
-	// items { id displayName currentState { status occurredAt } }
-	// cursor { next }
-	// }
-	// }
-	// ```
-	InstanceAlarms listInstanceAlarmsInstanceAlarmsAlarmsPage `json:"instanceAlarms"`
+// GetOrganizationId returns __getEnvironmentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentInput) GetId() string { return v.Id }
+
+// __getInstanceAlarmInput is used internally by genqlient
+type __getInstanceAlarmInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetInstanceAlarms returns listInstanceAlarmsResponse.InstanceAlarms, and is useful for accessing the field via an interface.
-func (v *listInstanceAlarmsResponse) GetInstanceAlarms() listInstanceAlarmsInstanceAlarmsAlarmsPage {
-	return v.InstanceAlarms
+// GetOrganizationId returns __getInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInstanceAlarmInput) GetId() string { return v.Id }
+
+// __getInstanceDependenciesInput is used internally by genqlient
+type __getInstanceDependenciesInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// updateInstanceAlarmResponse is returned by updateInstanceAlarm on success.
-type updateInstanceAlarmResponse struct {
-	// Update a registered alarm's mutable fields.
-	//
-	// Omit a field from the input to leave it unchanged.
-	//
-	// Requires `environment:update` on the alarm's environment.
-	UpdateInstanceAlarm updateInstanceAlarmUpdateInstanceAlarmAlarmPayload `json:"updateInstanceAlarm"`
+// GetOrganizationId returns __getInstanceDependenciesInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getInstanceDependenciesInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getInstanceDependenciesInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInstanceDependenciesInput) GetId() string { return v.Id }
+
+// __getInstanceInput is used internally by genqlient
+type __getInstanceInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// GetUpdateInstanceAlarm returns updateInstanceAlarmResponse.UpdateInstanceAlarm, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmResponse) GetUpdateInstanceAlarm() updateInstanceAlarmUpdateInstanceAlarmAlarmPayload {
-	return v.UpdateInstanceAlarm
+// GetOrganizationId returns __getInstanceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getInstanceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getInstanceInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInstanceInput) GetId() string { return v.Id }
+
+// __getProjectInput is used internally by genqlient
+type __getProjectInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
 }
 
-// updateInstanceAlarmUpdateInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
-type updateInstanceAlarmUpdateInstanceAlarmAlarmPayload struct {
+// GetOrganizationId returns __getProjectInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getProjectInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectInput) GetId() string { return v.Id }
+
+// __getResourceInput is used internally by genqlient
+type __getResourceInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __getResourceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getResourceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getResourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__getResourceInput) GetId() string { return v.Id }
+
+// __getResourceTypeInput is used internally by genqlient
+type __getResourceTypeInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __getResourceTypeInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__getResourceTypeInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __getResourceTypeInput.Id, and is useful for accessing the field via an interface.
+func (v *__getResourceTypeInput) GetId() string { return v.Id }
+
+// __linkComponentsInput is used internally by genqlient
+type __linkComponentsInput struct {
+	OrganizationId string              `json:"organizationId"`
+	Input          LinkComponentsInput `json:"input"`
+}
+
+// GetOrganizationId returns __linkComponentsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__linkComponentsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInput returns __linkComponentsInput.Input, and is useful for accessing the field via an interface.
+func (v *__linkComponentsInput) GetInput() LinkComponentsInput { return v.Input }
+
+// __listInstanceAlarmsInput is used internally by genqlient
+type __listInstanceAlarmsInput struct {
+	OrganizationId string                `json:"organizationId"`
+	Filter         *InstanceAlarmsFilter `json:"filter,omitempty"`
+	Cursor         *Cursor               `json:"cursor,omitempty"`
+}
+
+// GetOrganizationId returns __listInstanceAlarmsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__listInstanceAlarmsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetFilter returns __listInstanceAlarmsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listInstanceAlarmsInput) GetFilter() *InstanceAlarmsFilter { return v.Filter }
+
+// GetCursor returns __listInstanceAlarmsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__listInstanceAlarmsInput) GetCursor() *Cursor { return v.Cursor }
+
+// __listResourcesInput is used internally by genqlient
+type __listResourcesInput struct {
+	OrganizationId string           `json:"organizationId"`
+	Filter         *ResourcesFilter `json:"filter,omitempty"`
+	Cursor         *Cursor          `json:"cursor,omitempty"`
+}
+
+// GetOrganizationId returns __listResourcesInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__listResourcesInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetFilter returns __listResourcesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__listResourcesInput) GetFilter() *ResourcesFilter { return v.Filter }
+
+// GetCursor returns __listResourcesInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__listResourcesInput) GetCursor() *Cursor { return v.Cursor }
+
+// __publishResourceTypeInput is used internally by genqlient
+type __publishResourceTypeInput struct {
+	OrganizationId string                   `json:"organizationId"`
+	Input          PublishResourceTypeInput `json:"input"`
+}
+
+// GetOrganizationId returns __publishResourceTypeInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__publishResourceTypeInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInput returns __publishResourceTypeInput.Input, and is useful for accessing the field via an interface.
+func (v *__publishResourceTypeInput) GetInput() PublishResourceTypeInput { return v.Input }
+
+// __removeComponentInput is used internally by genqlient
+type __removeComponentInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __removeComponentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__removeComponentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __removeComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeComponentInput) GetId() string { return v.Id }
+
+// __removeEnvironmentDefaultInput is used internally by genqlient
+type __removeEnvironmentDefaultInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __removeEnvironmentDefaultInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__removeEnvironmentDefaultInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __removeEnvironmentDefaultInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeEnvironmentDefaultInput) GetId() string { return v.Id }
+
+// __removeRemoteReferenceInput is used internally by genqlient
+type __removeRemoteReferenceInput struct {
+	OrganizationId string                     `json:"organizationId"`
+	InstanceId     string                     `json:"instanceId"`
+	Input          RemoveRemoteReferenceInput `json:"input"`
+}
+
+// GetOrganizationId returns __removeRemoteReferenceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__removeRemoteReferenceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInstanceId returns __removeRemoteReferenceInput.InstanceId, and is useful for accessing the field via an interface.
+func (v *__removeRemoteReferenceInput) GetInstanceId() string { return v.InstanceId }
+
+// GetInput returns __removeRemoteReferenceInput.Input, and is useful for accessing the field via an interface.
+func (v *__removeRemoteReferenceInput) GetInput() RemoveRemoteReferenceInput { return v.Input }
+
+// __setComponentPositionInput is used internally by genqlient
+type __setComponentPositionInput struct {
+	OrganizationId string                    `json:"organizationId"`
+	Id             string                    `json:"id"`
+	Input          SetComponentPositionInput `json:"input"`
+}
+
+// GetOrganizationId returns __setComponentPositionInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__setComponentPositionInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __setComponentPositionInput.Id, and is useful for accessing the field via an interface.
+func (v *__setComponentPositionInput) GetId() string { return v.Id }
+
+// GetInput returns __setComponentPositionInput.Input, and is useful for accessing the field via an interface.
+func (v *__setComponentPositionInput) GetInput() SetComponentPositionInput { return v.Input }
+
+// __setEnvironmentDefaultInput is used internally by genqlient
+type __setEnvironmentDefaultInput struct {
+	OrganizationId string `json:"organizationId"`
+	EnvironmentId  string `json:"environmentId"`
+	ResourceId     string `json:"resourceId"`
+}
+
+// GetOrganizationId returns __setEnvironmentDefaultInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__setEnvironmentDefaultInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetEnvironmentId returns __setEnvironmentDefaultInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__setEnvironmentDefaultInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetResourceId returns __setEnvironmentDefaultInput.ResourceId, and is useful for accessing the field via an interface.
+func (v *__setEnvironmentDefaultInput) GetResourceId() string { return v.ResourceId }
+
+// __setRemoteReferenceInput is used internally by genqlient
+type __setRemoteReferenceInput struct {
+	OrganizationId string                  `json:"organizationId"`
+	InstanceId     string                  `json:"instanceId"`
+	ResourceId     string                  `json:"resourceId"`
+	Input          SetRemoteReferenceInput `json:"input"`
+}
+
+// GetOrganizationId returns __setRemoteReferenceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__setRemoteReferenceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInstanceId returns __setRemoteReferenceInput.InstanceId, and is useful for accessing the field via an interface.
+func (v *__setRemoteReferenceInput) GetInstanceId() string { return v.InstanceId }
+
+// GetResourceId returns __setRemoteReferenceInput.ResourceId, and is useful for accessing the field via an interface.
+func (v *__setRemoteReferenceInput) GetResourceId() string { return v.ResourceId }
+
+// GetInput returns __setRemoteReferenceInput.Input, and is useful for accessing the field via an interface.
+func (v *__setRemoteReferenceInput) GetInput() SetRemoteReferenceInput { return v.Input }
+
+// __unlinkComponentsInput is used internally by genqlient
+type __unlinkComponentsInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __unlinkComponentsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__unlinkComponentsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __unlinkComponentsInput.Id, and is useful for accessing the field via an interface.
+func (v *__unlinkComponentsInput) GetId() string { return v.Id }
+
+// __updateComponentInput is used internally by genqlient
+type __updateComponentInput struct {
+	OrganizationId string               `json:"organizationId"`
+	Id             string               `json:"id"`
+	Input          UpdateComponentInput `json:"input"`
+}
+
+// GetOrganizationId returns __updateComponentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__updateComponentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __updateComponentInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateComponentInput) GetId() string { return v.Id }
+
+// GetInput returns __updateComponentInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateComponentInput) GetInput() UpdateComponentInput { return v.Input }
+
+// __updateEnvironmentInput is used internally by genqlient
+type __updateEnvironmentInput struct {
+	OrganizationId string                 `json:"organizationId"`
+	Id             string                 `json:"id"`
+	Input          UpdateEnvironmentInput `json:"input"`
+}
+
+// GetOrganizationId returns __updateEnvironmentInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __updateEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentInput) GetId() string { return v.Id }
+
+// GetInput returns __updateEnvironmentInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentInput) GetInput() UpdateEnvironmentInput { return v.Input }
+
+// __updateInstanceAlarmInput is used internally by genqlient
+type __updateInstanceAlarmInput struct {
+	OrganizationId string                   `json:"organizationId"`
+	Id             string                   `json:"id"`
+	Input          UpdateInstanceAlarmInput `json:"input"`
+}
+
+// GetOrganizationId returns __updateInstanceAlarmInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__updateInstanceAlarmInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __updateInstanceAlarmInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateInstanceAlarmInput) GetId() string { return v.Id }
+
+// GetInput returns __updateInstanceAlarmInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateInstanceAlarmInput) GetInput() UpdateInstanceAlarmInput { return v.Input }
+
+// __updateProjectInput is used internally by genqlient
+type __updateProjectInput struct {
+	OrganizationId string             `json:"organizationId"`
+	Id             string             `json:"id"`
+	Input          UpdateProjectInput `json:"input"`
+}
+
+// GetOrganizationId returns __updateProjectInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __updateProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetId() string { return v.Id }
+
+// GetInput returns __updateProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectInput) GetInput() UpdateProjectInput { return v.Input }
+
+// __updateResourceInput is used internally by genqlient
+type __updateResourceInput struct {
+	OrganizationId string              `json:"organizationId"`
+	Id             string              `json:"id"`
+	Input          UpdateResourceInput `json:"input"`
+}
+
+// GetOrganizationId returns __updateResourceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__updateResourceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __updateResourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateResourceInput) GetId() string { return v.Id }
+
+// GetInput returns __updateResourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateResourceInput) GetInput() UpdateResourceInput { return v.Input }
+
+// addComponentAddComponentComponentPayload includes the requested fields of the GraphQL type ComponentPayload.
+type addComponentAddComponentComponentPayload struct {
 	// The object created/updated/deleted by the mutation. May be null if mutation failed.
-	Result updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
+	Result addComponentAddComponentComponentPayloadResultComponent `json:"result"`
 	// Indicates if the mutation completed successfully or not.
 	Successful bool `json:"successful"`
 	// A list of failed validations. May be blank or null if mutation succeeded.
-	Messages []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
+	Messages []addComponentAddComponentComponentPayloadMessagesValidationMessage `json:"messages"`
 }
 
-// GetResult returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetResult() updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm {
+// GetResult returns addComponentAddComponentComponentPayload.Result, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayload) GetResult() addComponentAddComponentComponentPayloadResultComponent {
 	return v.Result
 }
 
-// GetSuccessful returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetSuccessful() bool {
-	return v.Successful
-}
+// GetSuccessful returns addComponentAddComponentComponentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayload) GetSuccessful() bool { return v.Successful }
 
-// GetMessages returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetMessages() []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage {
+// GetMessages returns addComponentAddComponentComponentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayload) GetMessages() []addComponentAddComponentComponentPayloadMessagesValidationMessage {
 	return v.Messages
 }
 
-// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// addComponentAddComponentComponentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
 // The GraphQL type's documentation follows.
 //
 // Validation messages are returned when mutation input does not meet the requirements.
@@ -1049,7 +2048,7 @@ func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetMessages() []upd
 // set to optional in our API. This allows 'required field' messages
 // to be returned in the same manner as other validations. The only exceptions
 // are id fields, which may be required to perform updates or deletes.
-type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
+type addComponentAddComponentComponentPayloadMessagesValidationMessage struct {
 	// A unique error code for the type of validation used.
 	Code string `json:"code"`
 	// The input field that the error applies to. The field can be used to
@@ -1072,171 +2071,9166 @@ type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage
 	Message string `json:"message"`
 }
 
-// GetCode returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
+// GetCode returns addComponentAddComponentComponentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayloadMessagesValidationMessage) GetCode() string {
 	return v.Code
 }
 
-// GetField returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
+// GetField returns addComponentAddComponentComponentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayloadMessagesValidationMessage) GetField() string {
 	return v.Field
 }
 
-// GetMessage returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
+// GetMessage returns addComponentAddComponentComponentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayloadMessagesValidationMessage) GetMessage() string {
 	return v.Message
 }
 
-// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
+// addComponentAddComponentComponentPayloadResultComponent includes the requested fields of the GraphQL type Component.
 // The GraphQL type's documentation follows.
 //
-// A cloud metric alarm attached to an instance.
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
 //
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
 //
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm struct {
-	// Unique identifier for this alarm.
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type addComponentAddComponentComponentPayloadResultComponent struct {
 	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
-	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
-	CloudResourceId string `json:"cloudResourceId"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
-	ComparisonOperator string `json:"comparisonOperator"`
-	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
-	Threshold float64 `json:"threshold"`
-	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
-	Period int `json:"period"`
-	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
-	Metric *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric `json:"metric"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
 }
 
-// GetId returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+// GetId returns addComponentAddComponentComponentPayloadResultComponent.Id, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayloadResultComponent) GetId() string { return v.Id }
 
-// GetDisplayName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
-	return v.DisplayName
-}
+// GetName returns addComponentAddComponentComponentPayloadResultComponent.Name, and is useful for accessing the field via an interface.
+func (v *addComponentAddComponentComponentPayloadResultComponent) GetName() string { return v.Name }
 
-// GetCloudResourceId returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetCloudResourceId() string {
-	return v.CloudResourceId
+// addComponentResponse is returned by addComponent on success.
+type addComponentResponse struct {
+	// Add a component to a project's blueprint.
+	//
+	// Creates a new slot in the blueprint for the specified bundle. The component
+	// does not deploy anything on its own -- it defines *what* can be deployed.
+	// Instances are created in each environment when you deploy.
+	//
+	// ```graphql
+	// mutation {
+	// addComponent(
+	// organizationId: "my-org"
+	// projectId: "my-project"
+	// ociRepoName: "aws-aurora-postgres"
+	// input: { id: "database", name: "Primary Database" }
+	// ) {
+	// result { id name }
+	// successful
+	// }
+	// }
+	// ```
+	AddComponent addComponentAddComponentComponentPayload `json:"addComponent"`
 }
 
-// GetComparisonOperator returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetComparisonOperator() string {
-	return v.ComparisonOperator
+// GetAddComponent returns addComponentResponse.AddComponent, and is useful for accessing the field via an interface.
+func (v *addComponentResponse) GetAddComponent() addComponentAddComponentComponentPayload {
+	return v.AddComponent
 }
 
-// GetThreshold returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Threshold, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetThreshold() float64 {
-	return v.Threshold
+// cloneProjectCloneProjectProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type cloneProjectCloneProjectProjectPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result cloneProjectCloneProjectProjectPayloadResultProject `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []cloneProjectCloneProjectProjectPayloadMessagesValidationMessage `json:"messages"`
 }
 
-// GetPeriod returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Period, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetPeriod() int {
-	return v.Period
+// GetResult returns cloneProjectCloneProjectProjectPayload.Result, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayload) GetResult() cloneProjectCloneProjectProjectPayloadResultProject {
+	return v.Result
 }
 
-// GetMetric returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Metric, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetMetric() *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric {
-	return v.Metric
+// GetSuccessful returns cloneProjectCloneProjectProjectPayload.Successful, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns cloneProjectCloneProjectProjectPayload.Messages, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayload) GetMessages() []cloneProjectCloneProjectProjectPayloadMessagesValidationMessage {
+	return v.Messages
 }
 
-// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// cloneProjectCloneProjectProjectPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
 // The GraphQL type's documentation follows.
 //
-// The cloud metric an alarm is evaluating.
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
 //
-// Shape and populated fields vary by provider. AWS and Azure populate
-// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
-// are populated when the provider exposes them as structured key-value pairs.
-type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric struct {
-	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
-	Namespace string `json:"namespace"`
-	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type cloneProjectCloneProjectProjectPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns cloneProjectCloneProjectProjectPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns cloneProjectCloneProjectProjectPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns cloneProjectCloneProjectProjectPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// cloneProjectCloneProjectProjectPayloadResultProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type cloneProjectCloneProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
 	Name string `json:"name"`
-	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
-	Statistic string `json:"statistic"`
-	// Cloud region this metric is scoped to, when provider-reported.
-	Region string `json:"region"`
-	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
-	Dimensions []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
+	Attributes map[string]any `json:"-"`
 }
 
-// GetNamespace returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Namespace, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetNamespace() string {
-	return v.Namespace
+// GetId returns cloneProjectCloneProjectProjectPayloadResultProject.Id, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) GetId() string { return v.Id }
+
+// GetName returns cloneProjectCloneProjectProjectPayloadResultProject.Name, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) GetName() string { return v.Name }
+
+// GetDescription returns cloneProjectCloneProjectProjectPayloadResultProject.Description, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) GetDescription() string {
+	return v.Description
 }
 
-// GetName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Name, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetName() string {
+// GetAttributes returns cloneProjectCloneProjectProjectPayloadResultProject.Attributes, and is useful for accessing the field via an interface.
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*cloneProjectCloneProjectProjectPayloadResultProject
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.cloneProjectCloneProjectProjectPayloadResultProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal cloneProjectCloneProjectProjectPayloadResultProject.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcloneProjectCloneProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *cloneProjectCloneProjectProjectPayloadResultProject) __premarshalJSON() (*__premarshalcloneProjectCloneProjectProjectPayloadResultProject, error) {
+	var retval __premarshalcloneProjectCloneProjectProjectPayloadResultProject
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal cloneProjectCloneProjectProjectPayloadResultProject.Attributes: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// cloneProjectResponse is returned by cloneProject on success.
+type cloneProjectResponse struct {
+	// Create a new project by cloning another project's blueprint.
+	//
+	// All components and links from the source project are copied into the new project.
+	// The new project gets its own independent blueprint -- subsequent changes do not
+	// affect the source. Environments are **not** cloned; you must create them separately.
+	CloneProject cloneProjectCloneProjectProjectPayload `json:"cloneProject"`
+}
+
+// GetCloneProject returns cloneProjectResponse.CloneProject, and is useful for accessing the field via an interface.
+func (v *cloneProjectResponse) GetCloneProject() cloneProjectCloneProjectProjectPayload {
+	return v.CloneProject
+}
+
+// createEnvironmentCreateEnvironmentEnvironmentPayload includes the requested fields of the GraphQL type EnvironmentPayload.
+type createEnvironmentCreateEnvironmentEnvironmentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns createEnvironmentCreateEnvironmentEnvironmentPayload.Result, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayload) GetResult() createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment {
+	return v.Result
+}
+
+// GetSuccessful returns createEnvironmentCreateEnvironmentEnvironmentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns createEnvironmentCreateEnvironmentEnvironmentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayload) GetMessages() []createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+}
+
+// GetId returns createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetName returns createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentEnvironmentPayloadResultEnvironment) GetName() string {
 	return v.Name
 }
 
-// GetStatistic returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Statistic, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetStatistic() string {
-	return v.Statistic
+// createEnvironmentResponse is returned by createEnvironment on success.
+type createEnvironmentResponse struct {
+	// Create a new environment in a project.
+	//
+	// The `id` in the input becomes the environment's permanent identifier and cannot be
+	// changed after creation. The new environment starts empty with no deployed instances.
+	CreateEnvironment createEnvironmentCreateEnvironmentEnvironmentPayload `json:"createEnvironment"`
+}
+
+// GetCreateEnvironment returns createEnvironmentResponse.CreateEnvironment, and is useful for accessing the field via an interface.
+func (v *createEnvironmentResponse) GetCreateEnvironment() createEnvironmentCreateEnvironmentEnvironmentPayload {
+	return v.CreateEnvironment
+}
+
+// createInstanceAlarmCreateInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
+type createInstanceAlarmCreateInstanceAlarmAlarmPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetResult() createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm {
+	return v.Result
+}
+
+// GetSuccessful returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns createInstanceAlarmCreateInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayload) GetMessages() []createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
 }
 
-// GetRegion returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Region, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetRegion() string {
-	return v.Region
+// GetMessage returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
+// The GraphQL type's documentation follows.
+//
+// A cloud metric alarm attached to an instance.
+//
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
+//
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm struct {
+	// Unique identifier for this alarm.
+	Id string `json:"id"`
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
+	CloudResourceId string `json:"cloudResourceId"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
+	ComparisonOperator string `json:"comparisonOperator"`
+	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
+	Threshold float64 `json:"threshold"`
+	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
+	Period int `json:"period"`
+	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
+	Metric *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric `json:"metric"`
+}
+
+// GetId returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+
+// GetDisplayName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
+	return v.DisplayName
+}
+
+// GetCloudResourceId returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetCloudResourceId() string {
+	return v.CloudResourceId
+}
+
+// GetComparisonOperator returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetComparisonOperator() string {
+	return v.ComparisonOperator
+}
+
+// GetThreshold returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Threshold, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetThreshold() float64 {
+	return v.Threshold
+}
+
+// GetPeriod returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Period, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetPeriod() int {
+	return v.Period
+}
+
+// GetMetric returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm.Metric, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarm) GetMetric() *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric {
+	return v.Metric
+}
+
+// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// The GraphQL type's documentation follows.
+//
+// The cloud metric an alarm is evaluating.
+//
+// Shape and populated fields vary by provider. AWS and Azure populate
+// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
+// are populated when the provider exposes them as structured key-value pairs.
+type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric struct {
+	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
+	Namespace string `json:"namespace"`
+	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+	Name string `json:"name"`
+	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
+	Statistic string `json:"statistic"`
+	// Cloud region this metric is scoped to, when provider-reported.
+	Region string `json:"region"`
+	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
+	Dimensions []createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+}
+
+// GetNamespace returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Namespace, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Name, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetName() string {
+	return v.Name
+}
+
+// GetStatistic returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Statistic, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetStatistic() string {
+	return v.Statistic
+}
+
+// GetRegion returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Region, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetRegion() string {
+	return v.Region
+}
+
+// GetDimensions returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetric) GetDimensions() []createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension {
+	return v.Dimensions
+}
+
+// createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
+// The GraphQL type's documentation follows.
+//
+// A key-value pair identifying the specific cloud resource a metric applies to.
+//
+// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
+// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
+type createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension struct {
+	// Dimension name as defined by the cloud provider.
+	Name string `json:"name"`
+	// Dimension value identifying the monitored resource.
+	Value string `json:"value"`
+}
+
+// GetName returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
+	return v.Name
+}
+
+// GetValue returns createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmCreateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
+	return v.Value
+}
+
+// createInstanceAlarmResponse is returned by createInstanceAlarm on success.
+type createInstanceAlarmResponse struct {
+	// Register a cloud metric alarm with an instance.
+	//
+	// The alarm appears in the UI immediately and starts receiving state updates
+	// as soon as the cloud provider reports them. The `cloudResourceId` is used
+	// to correlate inbound webhooks (CloudWatch, Azure Monitor, GCP Cloud
+	// Monitoring, Alertmanager) back to this alarm — it must be unique within
+	// the instance.
+	//
+	// Requires `environment:update` on the alarm's environment.
+	CreateInstanceAlarm createInstanceAlarmCreateInstanceAlarmAlarmPayload `json:"createInstanceAlarm"`
+}
+
+// GetCreateInstanceAlarm returns createInstanceAlarmResponse.CreateInstanceAlarm, and is useful for accessing the field via an interface.
+func (v *createInstanceAlarmResponse) GetCreateInstanceAlarm() createInstanceAlarmCreateInstanceAlarmAlarmPayload {
+	return v.CreateInstanceAlarm
+}
+
+// createProjectCreateProjectProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type createProjectCreateProjectProjectPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result createProjectCreateProjectProjectPayloadResultProject `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []createProjectCreateProjectProjectPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns createProjectCreateProjectProjectPayload.Result, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayload) GetResult() createProjectCreateProjectProjectPayloadResultProject {
+	return v.Result
+}
+
+// GetSuccessful returns createProjectCreateProjectProjectPayload.Successful, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns createProjectCreateProjectProjectPayload.Messages, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayload) GetMessages() []createProjectCreateProjectProjectPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// createProjectCreateProjectProjectPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type createProjectCreateProjectProjectPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns createProjectCreateProjectProjectPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns createProjectCreateProjectProjectPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns createProjectCreateProjectProjectPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// createProjectCreateProjectProjectPayloadResultProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type createProjectCreateProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
+	Name string `json:"name"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
+	Attributes map[string]any `json:"-"`
+}
+
+// GetId returns createProjectCreateProjectProjectPayloadResultProject.Id, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadResultProject) GetId() string { return v.Id }
+
+// GetName returns createProjectCreateProjectProjectPayloadResultProject.Name, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadResultProject) GetName() string { return v.Name }
+
+// GetDescription returns createProjectCreateProjectProjectPayloadResultProject.Description, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadResultProject) GetDescription() string {
+	return v.Description
+}
+
+// GetAttributes returns createProjectCreateProjectProjectPayloadResultProject.Attributes, and is useful for accessing the field via an interface.
+func (v *createProjectCreateProjectProjectPayloadResultProject) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+func (v *createProjectCreateProjectProjectPayloadResultProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createProjectCreateProjectProjectPayloadResultProject
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createProjectCreateProjectProjectPayloadResultProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal createProjectCreateProjectProjectPayloadResultProject.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcreateProjectCreateProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+func (v *createProjectCreateProjectProjectPayloadResultProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createProjectCreateProjectProjectPayloadResultProject) __premarshalJSON() (*__premarshalcreateProjectCreateProjectProjectPayloadResultProject, error) {
+	var retval __premarshalcreateProjectCreateProjectProjectPayloadResultProject
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal createProjectCreateProjectProjectPayloadResultProject.Attributes: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// createProjectResponse is returned by createProject on success.
+type createProjectResponse struct {
+	// Create a new project in your organization.
+	//
+	// The `id` in the input becomes the project's permanent identifier and cannot be
+	// changed after creation. An empty blueprint is created automatically.
+	CreateProject createProjectCreateProjectProjectPayload `json:"createProject"`
+}
+
+// GetCreateProject returns createProjectResponse.CreateProject, and is useful for accessing the field via an interface.
+func (v *createProjectResponse) GetCreateProject() createProjectCreateProjectProjectPayload {
+	return v.CreateProject
+}
+
+// createResourceCreateResourceResourcePayload includes the requested fields of the GraphQL type ResourcePayload.
+type createResourceCreateResourceResourcePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result createResourceCreateResourceResourcePayloadResultResource `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []createResourceCreateResourceResourcePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns createResourceCreateResourceResourcePayload.Result, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayload) GetResult() createResourceCreateResourceResourcePayloadResultResource {
+	return v.Result
+}
+
+// GetSuccessful returns createResourceCreateResourceResourcePayload.Successful, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns createResourceCreateResourceResourcePayload.Messages, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayload) GetMessages() []createResourceCreateResourceResourcePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// createResourceCreateResourceResourcePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type createResourceCreateResourceResourcePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns createResourceCreateResourceResourcePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns createResourceCreateResourceResourcePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns createResourceCreateResourceResourcePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// createResourceCreateResourceResourcePayloadResultResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type createResourceCreateResourceResourcePayloadResultResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created. Determines whether it can be modified through the API.
+	Origin ResourceOrigin `json:"origin"`
+	// Key-value attributes assigned directly to this resource, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType *createResourceCreateResourceResourcePayloadResultResourceResourceType `json:"resourceType"`
+}
+
+// GetId returns createResourceCreateResourceResourcePayloadResultResource.Id, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResource) GetId() string { return v.Id }
+
+// GetName returns createResourceCreateResourceResourcePayloadResultResource.Name, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResource) GetName() string { return v.Name }
+
+// GetOrigin returns createResourceCreateResourceResourcePayloadResultResource.Origin, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResource) GetOrigin() ResourceOrigin {
+	return v.Origin
+}
+
+// GetAttributes returns createResourceCreateResourceResourcePayloadResultResource.Attributes, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResource) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+// GetResourceType returns createResourceCreateResourceResourcePayloadResultResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResource) GetResourceType() *createResourceCreateResourceResourcePayloadResultResourceResourceType {
+	return v.ResourceType
+}
+
+func (v *createResourceCreateResourceResourcePayloadResultResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createResourceCreateResourceResourcePayloadResultResource
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createResourceCreateResourceResourcePayloadResultResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal createResourceCreateResourceResourcePayloadResultResource.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcreateResourceCreateResourceResourcePayloadResultResource struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	ResourceType *createResourceCreateResourceResourcePayloadResultResourceResourceType `json:"resourceType"`
+}
+
+func (v *createResourceCreateResourceResourcePayloadResultResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createResourceCreateResourceResourcePayloadResultResource) __premarshalJSON() (*__premarshalcreateResourceCreateResourceResourcePayloadResultResource, error) {
+	var retval __premarshalcreateResourceCreateResourceResourcePayloadResultResource
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal createResourceCreateResourceResourcePayloadResultResource.Attributes: %w", err)
+		}
+	}
+	retval.ResourceType = v.ResourceType
+	return &retval, nil
+}
+
+// createResourceCreateResourceResourcePayloadResultResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type createResourceCreateResourceResourcePayloadResultResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns createResourceCreateResourceResourcePayloadResultResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *createResourceCreateResourceResourcePayloadResultResourceResourceType) GetId() string {
+	return v.Id
+}
+
+// createResourceResponse is returned by createResource on success.
+type createResourceResponse struct {
+	// Import a new resource into your organization.
+	//
+	// Creates a manually-managed resource (origin: `IMPORTED`) such as cloud credentials,
+	// a network configuration, or any other infrastructure output. The resource must
+	// conform to the schema defined by the specified resource type.
+	//
+	// ```graphql
+	// mutation {
+	// createResource(
+	// organizationId: "my-org"
+	// resourceTypeId: "aws-iam-role"
+	// input: { name: "CI/CD Role", payload: { arn: "arn:aws:iam::123:role/ci" } }
+	// ) {
+	// result { id name origin }
+	// successful
+	// messages { field message }
+	// }
+	// }
+	// ```
+	CreateResource createResourceCreateResourceResourcePayload `json:"createResource"`
+}
+
+// GetCreateResource returns createResourceResponse.CreateResource, and is useful for accessing the field via an interface.
+func (v *createResourceResponse) GetCreateResource() createResourceCreateResourceResourcePayload {
+	return v.CreateResource
+}
+
+// deleteEnvironmentDeleteEnvironmentEnvironmentPayload includes the requested fields of the GraphQL type EnvironmentPayload.
+type deleteEnvironmentDeleteEnvironmentEnvironmentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns deleteEnvironmentDeleteEnvironmentEnvironmentPayload.Result, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayload) GetResult() deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment {
+	return v.Result
+}
+
+// GetSuccessful returns deleteEnvironmentDeleteEnvironmentEnvironmentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns deleteEnvironmentDeleteEnvironmentEnvironmentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayload) GetMessages() []deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+}
+
+// GetId returns deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetName returns deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentDeleteEnvironmentEnvironmentPayloadResultEnvironment) GetName() string {
+	return v.Name
+}
+
+// deleteEnvironmentResponse is returned by deleteEnvironment on success.
+type deleteEnvironmentResponse struct {
+	// Delete an environment permanently.
+	//
+	// All instances must be decommissioned first. Query the environment's `deletable`
+	// field to check for blocking constraints before calling this mutation.
+	DeleteEnvironment deleteEnvironmentDeleteEnvironmentEnvironmentPayload `json:"deleteEnvironment"`
+}
+
+// GetDeleteEnvironment returns deleteEnvironmentResponse.DeleteEnvironment, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentResponse) GetDeleteEnvironment() deleteEnvironmentDeleteEnvironmentEnvironmentPayload {
+	return v.DeleteEnvironment
+}
+
+// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
+type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetResult() deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm {
+	return v.Result
+}
+
+// GetSuccessful returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload) GetMessages() []deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
+// The GraphQL type's documentation follows.
+//
+// A cloud metric alarm attached to an instance.
+//
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
+//
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm struct {
+	// Unique identifier for this alarm.
+	Id string `json:"id"`
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+}
+
+// GetId returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+
+// GetDisplayName returns deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmDeleteInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
+	return v.DisplayName
+}
+
+// deleteInstanceAlarmResponse is returned by deleteInstanceAlarm on success.
+type deleteInstanceAlarmResponse struct {
+	// Delete an alarm registration.
+	//
+	// Removes the alarm and any recorded state transitions. The underlying cloud
+	// provider alarm is unaffected.
+	//
+	// Requires `environment:update` on the alarm's environment.
+	DeleteInstanceAlarm deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload `json:"deleteInstanceAlarm"`
+}
+
+// GetDeleteInstanceAlarm returns deleteInstanceAlarmResponse.DeleteInstanceAlarm, and is useful for accessing the field via an interface.
+func (v *deleteInstanceAlarmResponse) GetDeleteInstanceAlarm() deleteInstanceAlarmDeleteInstanceAlarmAlarmPayload {
+	return v.DeleteInstanceAlarm
+}
+
+// deleteProjectDeleteProjectProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type deleteProjectDeleteProjectProjectPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result deleteProjectDeleteProjectProjectPayloadResultProject `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns deleteProjectDeleteProjectProjectPayload.Result, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayload) GetResult() deleteProjectDeleteProjectProjectPayloadResultProject {
+	return v.Result
+}
+
+// GetSuccessful returns deleteProjectDeleteProjectProjectPayload.Successful, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns deleteProjectDeleteProjectProjectPayload.Messages, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayload) GetMessages() []deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// deleteProjectDeleteProjectProjectPayloadResultProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type deleteProjectDeleteProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
+	Name string `json:"name"`
+}
+
+// GetId returns deleteProjectDeleteProjectProjectPayloadResultProject.Id, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayloadResultProject) GetId() string { return v.Id }
+
+// GetName returns deleteProjectDeleteProjectProjectPayloadResultProject.Name, and is useful for accessing the field via an interface.
+func (v *deleteProjectDeleteProjectProjectPayloadResultProject) GetName() string { return v.Name }
+
+// deleteProjectResponse is returned by deleteProject on success.
+type deleteProjectResponse struct {
+	// Delete a project permanently.
+	//
+	// All environments must be deleted first. Query the project's `deletable` field
+	// to check for blocking constraints before calling this mutation.
+	DeleteProject deleteProjectDeleteProjectProjectPayload `json:"deleteProject"`
+}
+
+// GetDeleteProject returns deleteProjectResponse.DeleteProject, and is useful for accessing the field via an interface.
+func (v *deleteProjectResponse) GetDeleteProject() deleteProjectDeleteProjectProjectPayload {
+	return v.DeleteProject
+}
+
+// deleteResourceDeleteResourceResourcePayload includes the requested fields of the GraphQL type ResourcePayload.
+type deleteResourceDeleteResourceResourcePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result deleteResourceDeleteResourceResourcePayloadResultResource `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns deleteResourceDeleteResourceResourcePayload.Result, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayload) GetResult() deleteResourceDeleteResourceResourcePayloadResultResource {
+	return v.Result
+}
+
+// GetSuccessful returns deleteResourceDeleteResourceResourcePayload.Successful, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns deleteResourceDeleteResourceResourcePayload.Messages, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayload) GetMessages() []deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// deleteResourceDeleteResourceResourcePayloadResultResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type deleteResourceDeleteResourceResourcePayloadResultResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+}
+
+// GetId returns deleteResourceDeleteResourceResourcePayloadResultResource.Id, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayloadResultResource) GetId() string { return v.Id }
+
+// GetName returns deleteResourceDeleteResourceResourcePayloadResultResource.Name, and is useful for accessing the field via an interface.
+func (v *deleteResourceDeleteResourceResourcePayloadResultResource) GetName() string { return v.Name }
+
+// deleteResourceResponse is returned by deleteResource on success.
+type deleteResourceResponse struct {
+	// Delete an imported resource.
+	//
+	// Only resources with origin `IMPORTED` can be deleted directly. Provisioned resources
+	// are automatically removed when their owning instance is decommissioned.
+	//
+	// The resource cannot be deleted if it is currently referenced by any active connections.
+	// Disconnect all consumers before deleting.
+	DeleteResource deleteResourceDeleteResourceResourcePayload `json:"deleteResource"`
+}
+
+// GetDeleteResource returns deleteResourceResponse.DeleteResource, and is useful for accessing the field via an interface.
+func (v *deleteResourceResponse) GetDeleteResource() deleteResourceDeleteResourceResourcePayload {
+	return v.DeleteResource
+}
+
+// deleteResourceTypeDeleteResourceTypeResourceTypePayload includes the requested fields of the GraphQL type ResourceTypePayload.
+type deleteResourceTypeDeleteResourceTypeResourceTypePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns deleteResourceTypeDeleteResourceTypeResourceTypePayload.Result, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayload) GetResult() deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType {
+	return v.Result
+}
+
+// GetSuccessful returns deleteResourceTypeDeleteResourceTypeResourceTypePayload.Successful, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns deleteResourceTypeDeleteResourceTypeResourceTypePayload.Messages, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayload) GetMessages() []deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+	Name string `json:"name"`
+}
+
+// GetId returns deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType.Id, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType) GetId() string {
+	return v.Id
+}
+
+// GetName returns deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType.Name, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeDeleteResourceTypeResourceTypePayloadResultResourceType) GetName() string {
+	return v.Name
+}
+
+// deleteResourceTypeResponse is returned by deleteResourceType on success.
+type deleteResourceTypeResponse struct {
+	// **Deprecated — use at your own risk.** This mutation exists only to bridge V0's
+	// `deleteArtifactDefinition` into V2 while resource types are being migrated to OCI.
+	// New integrations should use the OCI-native publishing flow. This mutation may be
+	// removed or change behavior without notice.
+	//
+	// Delete a resource type. The resource type cannot be deleted while it is still in
+	// use — either as a dependency or output in a bundle, or by any existing
+	// imported/provisioned resources of this type. Remove those consumers first.
+	DeleteResourceType deleteResourceTypeDeleteResourceTypeResourceTypePayload `json:"deleteResourceType"`
+}
+
+// GetDeleteResourceType returns deleteResourceTypeResponse.DeleteResourceType, and is useful for accessing the field via an interface.
+func (v *deleteResourceTypeResponse) GetDeleteResourceType() deleteResourceTypeDeleteResourceTypeResourceTypePayload {
+	return v.DeleteResourceType
+}
+
+// exportResourceExportResourceResourceWithSensitiveValuesPayload includes the requested fields of the GraphQL type ResourceWithSensitiveValuesPayload.
+type exportResourceExportResourceResourceWithSensitiveValuesPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns exportResourceExportResourceResourceWithSensitiveValuesPayload.Result, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayload) GetResult() exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues {
+	return v.Result
+}
+
+// GetSuccessful returns exportResourceExportResourceResourceWithSensitiveValuesPayload.Successful, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns exportResourceExportResourceResourceWithSensitiveValuesPayload.Messages, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayload) GetMessages() []exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues includes the requested fields of the GraphQL type ResourceWithSensitiveValues.
+// The GraphQL type's documentation follows.
+//
+// A resource with its sensitive payload values revealed, returned by the `exportResource` mutation.
+//
+// Unlike the regular `Resource` type — where fields marked `$md.sensitive` in the resource
+// type's schema are masked — this type exposes the raw values so they can be consumed by
+// automation or copied into downstream systems. Requesting this type is recorded in the
+// audit log.
+type exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created.
+	Origin ResourceOrigin `json:"origin"`
+	// The resource's payload with `$md.sensitive` fields unmasked. The shape is defined by
+	// the resource type's schema.
+	Payload map[string]any `json:"-"`
+	// The resource rendered in the requested `format`. For `json` this is a stringified JSON
+	// document of the payload; for resource-type-specific formats (e.g. `yaml`, `env`) this is
+	// the template output defined by the resource type's schema.
+	Rendered string `json:"rendered"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType `json:"resourceType"`
+}
+
+// GetId returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Id, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetId() string {
+	return v.Id
+}
+
+// GetName returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Name, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetName() string {
+	return v.Name
+}
+
+// GetOrigin returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Origin, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetOrigin() ResourceOrigin {
+	return v.Origin
+}
+
+// GetPayload returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Payload, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetPayload() map[string]any {
+	return v.Payload
+}
+
+// GetRendered returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Rendered, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetRendered() string {
+	return v.Rendered
+}
+
+// GetResourceType returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.ResourceType, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) GetResourceType() exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType {
+	return v.ResourceType
+}
+
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues
+		Payload json.RawMessage `json:"payload"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Payload
+		src := firstPass.Payload
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Payload: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalexportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Payload json.RawMessage `json:"payload"`
+
+	Rendered string `json:"rendered"`
+
+	ResourceType exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType `json:"resourceType"`
+}
+
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues) __premarshalJSON() (*__premarshalexportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues, error) {
+	var retval __premarshalexportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	{
+
+		dst := &retval.Payload
+		src := v.Payload
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValues.Payload: %w", err)
+		}
+	}
+	retval.Rendered = v.Rendered
+	retval.ResourceType = v.ResourceType
+	return &retval, nil
+}
+
+// exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType.Id, and is useful for accessing the field via an interface.
+func (v *exportResourceExportResourceResourceWithSensitiveValuesPayloadResultResourceWithSensitiveValuesResourceType) GetId() string {
+	return v.Id
+}
+
+// exportResourceResponse is returned by exportResource on success.
+type exportResourceResponse struct {
+	// Export a resource, returning it along with its unmasked `payload` and a `rendered`
+	// copy in the requested `format` (defaults to `json`).
+	//
+	// Exports are recorded in the audit log so that access to sensitive payload data —
+	// credentials, connection strings, IaC outputs — is attributable to the actor who
+	// performed it. The resource itself is not modified.
+	//
+	// Works for both imported and provisioned resources. The caller must have permission
+	// to view the resource.
+	ExportResource exportResourceExportResourceResourceWithSensitiveValuesPayload `json:"exportResource"`
+}
+
+// GetExportResource returns exportResourceResponse.ExportResource, and is useful for accessing the field via an interface.
+func (v *exportResourceResponse) GetExportResource() exportResourceExportResourceResourceWithSensitiveValuesPayload {
+	return v.ExportResource
+}
+
+// forkEnvironmentForkEnvironmentEnvironmentPayload includes the requested fields of the GraphQL type EnvironmentPayload.
+type forkEnvironmentForkEnvironmentEnvironmentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns forkEnvironmentForkEnvironmentEnvironmentPayload.Result, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayload) GetResult() forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment {
+	return v.Result
+}
+
+// GetSuccessful returns forkEnvironmentForkEnvironmentEnvironmentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns forkEnvironmentForkEnvironmentEnvironmentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayload) GetMessages() []forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+}
+
+// GetId returns forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetName returns forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentForkEnvironmentEnvironmentPayloadResultEnvironment) GetName() string {
+	return v.Name
+}
+
+// forkEnvironmentResponse is returned by forkEnvironment on success.
+type forkEnvironmentResponse struct {
+	// Create a new environment by forking an existing one.
+	//
+	// The new environment is linked to the parent via its `parent` field. Instances
+	// are initialized from the project's components and seeded with the parent's
+	// instance `params`. Secrets and remote references are not copied — use
+	// `copyInstance` per instance to carry those over as well. Pass
+	// `copyEnvironmentDefaults: true` to also copy the parent's default resource
+	// connections.
+	ForkEnvironment forkEnvironmentForkEnvironmentEnvironmentPayload `json:"forkEnvironment"`
+}
+
+// GetForkEnvironment returns forkEnvironmentResponse.ForkEnvironment, and is useful for accessing the field via an interface.
+func (v *forkEnvironmentResponse) GetForkEnvironment() forkEnvironmentForkEnvironmentEnvironmentPayload {
+	return v.ForkEnvironment
+}
+
+// getComponentComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type getComponentComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+	// Optional free-text description of this component's purpose.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this component.
+	Attributes map[string]any `json:"-"`
+	// Position on the visual canvas. Null if never placed.
+	Position *getComponentComponentPosition `json:"position"`
+	// The OCI repository (bundle) this component is based on.
+	OciRepo getComponentComponentOciRepo `json:"ociRepo"`
+	// The project that owns this component.
+	Project getComponentComponentProject `json:"project"`
+	// Whether this component can be safely deleted. Check `constraints` for blocking conditions.
+	Deletable getComponentComponentDeletable `json:"deletable"`
+}
+
+// GetId returns getComponentComponent.Id, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetId() string { return v.Id }
+
+// GetName returns getComponentComponent.Name, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetName() string { return v.Name }
+
+// GetDescription returns getComponentComponent.Description, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetDescription() string { return v.Description }
+
+// GetAttributes returns getComponentComponent.Attributes, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetAttributes() map[string]any { return v.Attributes }
+
+// GetPosition returns getComponentComponent.Position, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetPosition() *getComponentComponentPosition { return v.Position }
+
+// GetOciRepo returns getComponentComponent.OciRepo, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetOciRepo() getComponentComponentOciRepo { return v.OciRepo }
+
+// GetProject returns getComponentComponent.Project, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetProject() getComponentComponentProject { return v.Project }
+
+// GetDeletable returns getComponentComponent.Deletable, and is useful for accessing the field via an interface.
+func (v *getComponentComponent) GetDeletable() getComponentComponentDeletable { return v.Deletable }
+
+func (v *getComponentComponent) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getComponentComponent
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getComponentComponent = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getComponentComponent.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetComponentComponent struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	Position *getComponentComponentPosition `json:"position"`
+
+	OciRepo getComponentComponentOciRepo `json:"ociRepo"`
+
+	Project getComponentComponentProject `json:"project"`
+
+	Deletable getComponentComponentDeletable `json:"deletable"`
+}
+
+func (v *getComponentComponent) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getComponentComponent) __premarshalJSON() (*__premarshalgetComponentComponent, error) {
+	var retval __premarshalgetComponentComponent
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getComponentComponent.Attributes: %w", err)
+		}
+	}
+	retval.Position = v.Position
+	retval.OciRepo = v.OciRepo
+	retval.Project = v.Project
+	retval.Deletable = v.Deletable
+	return &retval, nil
+}
+
+// getComponentComponentDeletable includes the requested fields of the GraphQL type Deletable.
+// The GraphQL type's documentation follows.
+//
+// Lifecycle check indicating whether a resource can safely be deleted.
+//
+// Before deleting a project or environment, query this field to determine if deletion is
+// allowed. When `result` is `false`, the `constraints` list explains exactly what is
+// blocking deletion and which resources need to be resolved first.
+//
+// A resource is deletable only when **all** of the following are true:
+// - No child instances are provisioned or in a failed state
+// - No deployments are currently pending or running
+// - No dependent child resources exist (e.g., environments in a project, forks of an environment)
+type getComponentComponentDeletable struct {
+	// Whether the resource can be safely deleted right now.
+	Result bool `json:"result"`
+	// The list of conditions preventing deletion. Empty when `result` is `true`.
+	Constraints []getComponentComponentDeletableConstraintsDeletionConstraint `json:"constraints"`
+}
+
+// GetResult returns getComponentComponentDeletable.Result, and is useful for accessing the field via an interface.
+func (v *getComponentComponentDeletable) GetResult() bool { return v.Result }
+
+// GetConstraints returns getComponentComponentDeletable.Constraints, and is useful for accessing the field via an interface.
+func (v *getComponentComponentDeletable) GetConstraints() []getComponentComponentDeletableConstraintsDeletionConstraint {
+	return v.Constraints
+}
+
+// getComponentComponentDeletableConstraintsDeletionConstraint includes the requested fields of the GraphQL type DeletionConstraint.
+// The GraphQL type's documentation follows.
+//
+// A specific condition that prevents a resource from being deleted.
+//
+// Each constraint identifies the **blocking resource** (by type and id) and provides a
+// human-readable message explaining what must be resolved before deletion can proceed.
+// For example, an environment cannot be deleted while it contains provisioned instances,
+// and a project cannot be deleted while it has environments.
+//
+// To resolve a constraint, address the blocking condition described in `message` -- typically
+// by decommissioning or removing the resource identified by `type` and `id`.
+type getComponentComponentDeletableConstraintsDeletionConstraint struct {
+	// Human-readable explanation of why deletion is blocked.
+	Message string `json:"message"`
+	// The kind of resource causing the block (e.g., `instance`, `environment`).
+	Type string `json:"type"`
+	// The identifier of the blocking resource.
+	Id string `json:"id"`
+}
+
+// GetMessage returns getComponentComponentDeletableConstraintsDeletionConstraint.Message, and is useful for accessing the field via an interface.
+func (v *getComponentComponentDeletableConstraintsDeletionConstraint) GetMessage() string {
+	return v.Message
+}
+
+// GetType returns getComponentComponentDeletableConstraintsDeletionConstraint.Type, and is useful for accessing the field via an interface.
+func (v *getComponentComponentDeletableConstraintsDeletionConstraint) GetType() string { return v.Type }
+
+// GetId returns getComponentComponentDeletableConstraintsDeletionConstraint.Id, and is useful for accessing the field via an interface.
+func (v *getComponentComponentDeletableConstraintsDeletionConstraint) GetId() string { return v.Id }
+
+// getComponentComponentOciRepo includes the requested fields of the GraphQL type OciRepo.
+// The GraphQL type's documentation follows.
+//
+// An OCI repository in your organization's bundle catalog.
+//
+// An OCI repository is the container for all published versions of a single
+// infrastructure-as-code package. It is analogous to a Docker image repository
+// but for Massdriver bundles.
+//
+// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
+//
+// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
+// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
+// that always point to the newest matching tag
+//
+// To fetch a specific bundle version from a repository, use the `bundle` query
+// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
+type getComponentComponentOciRepo struct {
+	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
+	Name string `json:"name"`
+}
+
+// GetName returns getComponentComponentOciRepo.Name, and is useful for accessing the field via an interface.
+func (v *getComponentComponentOciRepo) GetName() string { return v.Name }
+
+// getComponentComponentPosition includes the requested fields of the GraphQL type ComponentPosition.
+// The GraphQL type's documentation follows.
+//
+// A component's position on the visual canvas, in pixel coordinates.
+type getComponentComponentPosition struct {
+	// Horizontal offset in pixels from the canvas origin.
+	X int `json:"x"`
+	// Vertical offset in pixels from the canvas origin.
+	Y int `json:"y"`
+}
+
+// GetX returns getComponentComponentPosition.X, and is useful for accessing the field via an interface.
+func (v *getComponentComponentPosition) GetX() int { return v.X }
+
+// GetY returns getComponentComponentPosition.Y, and is useful for accessing the field via an interface.
+func (v *getComponentComponentPosition) GetY() int { return v.Y }
+
+// getComponentComponentProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type getComponentComponentProject struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getComponentComponentProject.Id, and is useful for accessing the field via an interface.
+func (v *getComponentComponentProject) GetId() string { return v.Id }
+
+// getComponentLinksComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type getComponentLinksComponent struct {
+	// The project that owns this component.
+	Project getComponentLinksComponentProject `json:"project"`
+}
+
+// GetProject returns getComponentLinksComponent.Project, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponent) GetProject() getComponentLinksComponentProject { return v.Project }
+
+// getComponentLinksComponentProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type getComponentLinksComponentProject struct {
+	// The infrastructure blueprint defining this project's components and their connections.
+	Blueprint getComponentLinksComponentProjectBlueprint `json:"blueprint"`
+}
+
+// GetBlueprint returns getComponentLinksComponentProject.Blueprint, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProject) GetBlueprint() getComponentLinksComponentProjectBlueprint {
+	return v.Blueprint
+}
+
+// getComponentLinksComponentProjectBlueprint includes the requested fields of the GraphQL type Blueprint.
+// The GraphQL type's documentation follows.
+//
+// A project's infrastructure blueprint -- the design-time architecture.
+//
+// The blueprint is the canonical description of how your infrastructure fits
+// together. It contains **components** (the bundles you want to deploy) and
+// **links** (the wiring between them).
+//
+// Every project has exactly one blueprint. When you deploy to an environment,
+// the blueprint is realized as an **environment blueprint** containing live
+// **instances** and **connections**.
+//
+// ```mermaid
+// graph TB
+// subgraph "Design Time (Blueprint)"
+// C1["Component: database"] ---|"Link"| C2["Component: cache"]
+// end
+// subgraph "Runtime (Environment Blueprint)"
+// I1["Instance: database"] ---|"Connection"| I2["Instance: cache"]
+// end
+// C1 -.->|"deployed to"| I1
+// C2 -.->|"deployed to"| I2
+// ```
+type getComponentLinksComponentProjectBlueprint struct {
+	// Paginated list of links between components in this blueprint.
+	//
+	// Each link declares a dependency from one component's output to another's input.
+	// Defaults to chronological order by creation time.
+	Links getComponentLinksComponentProjectBlueprintLinksLinksPage `json:"links"`
+}
+
+// GetLinks returns getComponentLinksComponentProjectBlueprint.Links, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprint) GetLinks() getComponentLinksComponentProjectBlueprintLinksLinksPage {
+	return v.Links
+}
+
+// getComponentLinksComponentProjectBlueprintLinksLinksPage includes the requested fields of the GraphQL type LinksPage.
+type getComponentLinksComponentProjectBlueprintLinksLinksPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor `json:"cursor"`
+	// A list of type link.
+	Items []getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink `json:"items"`
+}
+
+// GetCursor returns getComponentLinksComponentProjectBlueprintLinksLinksPage.Cursor, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPage) GetCursor() getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor {
+	return v.Cursor
+}
+
+// GetItems returns getComponentLinksComponentProjectBlueprintLinksLinksPage.Items, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPage) GetItems() []getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink {
+	return v.Items
+}
+
+// getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+}
+
+// GetNext returns getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
+
+// getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
+	// The source component that produces the output.
+	FromComponent *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent `json:"fromComponent"`
+	// The destination component that consumes the input.
+	ToComponent *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent `json:"toComponent"`
+}
+
+// GetId returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink.Id, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink) GetId() string {
+	return v.Id
+}
+
+// GetFromField returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink.FromField, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink) GetFromField() string {
+	return v.FromField
+}
+
+// GetToField returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink.ToField, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink) GetToField() string {
+	return v.ToField
+}
+
+// GetFromComponent returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink.FromComponent, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink) GetFromComponent() *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent {
+	return v.FromComponent
+}
+
+// GetToComponent returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink.ToComponent, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLink) GetToComponent() *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent {
+	return v.ToComponent
+}
+
+// getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent.Id, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkFromComponent) GetId() string {
+	return v.Id
+}
+
+// getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent.Id, and is useful for accessing the field via an interface.
+func (v *getComponentLinksComponentProjectBlueprintLinksLinksPageItemsLinkToComponent) GetId() string {
+	return v.Id
+}
+
+// getComponentLinksResponse is returned by getComponentLinks on success.
+type getComponentLinksResponse struct {
+	// Fetch a single component by its ID.
+	//
+	// Returns null with a `NOT_FOUND` error if the component does not exist or
+	// is not visible to the caller.
+	//
+	// ```graphql
+	// query {
+	// component(organizationId: "my-org", id: "my-project-database") {
+	// id
+	// name
+	// instances {
+	// items { id environment { id } }
+	// }
+	// }
+	// }
+	// ```
+	Component getComponentLinksComponent `json:"component"`
+}
+
+// GetComponent returns getComponentLinksResponse.Component, and is useful for accessing the field via an interface.
+func (v *getComponentLinksResponse) GetComponent() getComponentLinksComponent { return v.Component }
+
+// getComponentResponse is returned by getComponent on success.
+type getComponentResponse struct {
+	// Fetch a single component by its ID.
+	//
+	// Returns null with a `NOT_FOUND` error if the component does not exist or
+	// is not visible to the caller.
+	//
+	// ```graphql
+	// query {
+	// component(organizationId: "my-org", id: "my-project-database") {
+	// id
+	// name
+	// instances {
+	// items { id environment { id } }
+	// }
+	// }
+	// }
+	// ```
+	Component getComponentComponent `json:"component"`
+}
+
+// GetComponent returns getComponentResponse.Component, and is useful for accessing the field via an interface.
+func (v *getComponentResponse) GetComponent() getComponentComponent { return v.Component }
+
+// getEnvironmentDefaultsEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type getEnvironmentDefaultsEnvironment struct {
+	Id string `json:"id"`
+	// Paginated list of default resources for this environment.
+	//
+	// Defaults are pre-assigned resources (like a shared VPC or DNS zone) that instances
+	// automatically inherit when they require a matching resource type. Only one default
+	// per resource type is allowed.
+	Defaults getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage `json:"defaults"`
+}
+
+// GetId returns getEnvironmentDefaultsEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironment) GetId() string { return v.Id }
+
+// GetDefaults returns getEnvironmentDefaultsEnvironment.Defaults, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironment) GetDefaults() getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage {
+	return v.Defaults
+}
+
+// getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage includes the requested fields of the GraphQL type EnvironmentDefaultsPage.
+type getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type environment_default.
+	Items []getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault `json:"items"`
+}
+
+// GetCursor returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage) GetCursor() getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor {
+	return v.Cursor
+}
+
+// GetItems returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage.Items, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage) GetItems() []getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault {
+	return v.Items
+}
+
+// getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+}
+
+// GetNext returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
+
+// getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
+// The GraphQL type's documentation follows.
+//
+// An environment default that automatically provides a resource to instances.
+//
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault struct {
+	// Unique identifier for this environment default.
+	Id string `json:"id"`
+	// The resource that is set as the default for its type.
+	Resource getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource `json:"resource"`
+}
+
+// GetId returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetId() string {
+	return v.Id
+}
+
+// GetResource returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.Resource, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetResource() getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource {
+	return v.Resource
+}
+
+// getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource includes the requested fields of the GraphQL type EnvironmentDefaultResource.
+// The GraphQL type's documentation follows.
+//
+// A resource referenced by an environment default.
+//
+// This represents the actual cloud resource (e.g., a VPC or DNS zone) that has been
+// designated as the default for its resource type within an environment.
+type getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource struct {
+	// The resource's unique identifier.
+	Id string `json:"id"`
+	// Human-readable name of the resource.
+	Name string `json:"name"`
+	// The resource type (e.g., `massdriver/aws-vpc`) that this resource conforms to.
+	ResourceType *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType `json:"resourceType"`
+}
+
+// GetId returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetId() string {
+	return v.Id
+}
+
+// GetName returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.Name, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetName() string {
+	return v.Name
+}
+
+// GetResourceType returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetResourceType() *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType {
+	return v.ResourceType
+}
+
+// getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType) GetId() string {
+	return v.Id
+}
+
+// getEnvironmentDefaultsResponse is returned by getEnvironmentDefaults on success.
+type getEnvironmentDefaultsResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment getEnvironmentDefaultsEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns getEnvironmentDefaultsResponse.Environment, and is useful for accessing the field via an interface.
+func (v *getEnvironmentDefaultsResponse) GetEnvironment() getEnvironmentDefaultsEnvironment {
+	return v.Environment
+}
+
+// getEnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type getEnvironmentEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+	// Free-text description of what this environment is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this environment. Attributes cascade to instances. Must conform to your organization's custom attributes for the `ENVIRONMENT` scope.
+	Attributes map[string]any `json:"-"`
+	// The full attribute map the authorization system evaluates policies against for
+	// this environment — user attributes merged with the parent project (project wins on
+	// conflict) plus auto-injected `md-*` system attributes.
+	//
+	// System attributes always present on an environment:
+	// - `md-id` — the environment's identifier
+	// - `md-project` — the project's identifier
+	// - `md-environment` — the environment's local identifier
+	EffectiveAttributes map[string]any `json:"-"`
+	// The parent project that this environment belongs to.
+	Project getEnvironmentEnvironmentProject `json:"project"`
+	// Aggregated cloud-provider cost metrics for all instances in this environment.
+	Cost getEnvironmentEnvironmentCostCostSummary `json:"cost"`
+	// Whether this environment can be safely deleted. Check `constraints` for blocking conditions.
+	Deletable getEnvironmentEnvironmentDeletable `json:"deletable"`
+}
+
+// GetId returns getEnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetId() string { return v.Id }
+
+// GetName returns getEnvironmentEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetName() string { return v.Name }
+
+// GetDescription returns getEnvironmentEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetDescription() string { return v.Description }
+
+// GetAttributes returns getEnvironmentEnvironment.Attributes, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetAttributes() map[string]any { return v.Attributes }
+
+// GetEffectiveAttributes returns getEnvironmentEnvironment.EffectiveAttributes, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetEffectiveAttributes() map[string]any {
+	return v.EffectiveAttributes
+}
+
+// GetProject returns getEnvironmentEnvironment.Project, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetProject() getEnvironmentEnvironmentProject { return v.Project }
+
+// GetCost returns getEnvironmentEnvironment.Cost, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetCost() getEnvironmentEnvironmentCostCostSummary { return v.Cost }
+
+// GetDeletable returns getEnvironmentEnvironment.Deletable, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetDeletable() getEnvironmentEnvironmentDeletable {
+	return v.Deletable
+}
+
+func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getEnvironmentEnvironment
+		Attributes          json.RawMessage `json:"attributes"`
+		EffectiveAttributes json.RawMessage `json:"effectiveAttributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getEnvironmentEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getEnvironmentEnvironment.Attributes: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.EffectiveAttributes
+		src := firstPass.EffectiveAttributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getEnvironmentEnvironment.EffectiveAttributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetEnvironmentEnvironment struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	EffectiveAttributes json.RawMessage `json:"effectiveAttributes"`
+
+	Project getEnvironmentEnvironmentProject `json:"project"`
+
+	Cost getEnvironmentEnvironmentCostCostSummary `json:"cost"`
+
+	Deletable getEnvironmentEnvironmentDeletable `json:"deletable"`
+}
+
+func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getEnvironmentEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentEnvironment, error) {
+	var retval __premarshalgetEnvironmentEnvironment
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getEnvironmentEnvironment.Attributes: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.EffectiveAttributes
+		src := v.EffectiveAttributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getEnvironmentEnvironment.EffectiveAttributes: %w", err)
+		}
+	}
+	retval.Project = v.Project
+	retval.Cost = v.Cost
+	retval.Deletable = v.Deletable
+	return &retval, nil
+}
+
+// getEnvironmentEnvironmentCostCostSummary includes the requested fields of the GraphQL type CostSummary.
+// The GraphQL type's documentation follows.
+//
+// Aggregated cloud-provider cost metrics for a project or environment.
+//
+// Cost data is sourced from your cloud provider's billing APIs and refreshed periodically.
+// Each metric is a `CostSample` containing an amount and currency. All four metrics are
+// always present, but their inner `amount` and `currency` may be null if billing data has
+// not yet been ingested.
+//
+// - **last_month** -- Total spend for the most recent complete billing cycle.
+// - **monthly_average** -- Average monthly spend across all available billing cycles.
+// - **last_day** -- Total spend for the most recent 24-hour period.
+// - **daily_average** -- Average daily spend over the last 7 days.
+type getEnvironmentEnvironmentCostCostSummary struct {
+	// Total cost for the most recent complete billing cycle.
+	LastMonth getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample `json:"lastMonth"`
+	// Average monthly cost across all available billing cycles.
+	MonthlyAverage getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
+	// Total cost for the most recent 24-hour period.
+	LastDay getEnvironmentEnvironmentCostCostSummaryLastDayCostSample `json:"lastDay"`
+	// Average daily cost over the last 7 days.
+	DailyAverage getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
+}
+
+// GetLastMonth returns getEnvironmentEnvironmentCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummary) GetLastMonth() getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample {
+	return v.LastMonth
+}
+
+// GetMonthlyAverage returns getEnvironmentEnvironmentCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummary) GetMonthlyAverage() getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample {
+	return v.MonthlyAverage
+}
+
+// GetLastDay returns getEnvironmentEnvironmentCostCostSummary.LastDay, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummary) GetLastDay() getEnvironmentEnvironmentCostCostSummaryLastDayCostSample {
+	return v.LastDay
+}
+
+// GetDailyAverage returns getEnvironmentEnvironmentCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummary) GetDailyAverage() getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample {
+	return v.DailyAverage
+}
+
+// getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample) GetAmount() float64 {
+	return v.Amount
+}
+
+// GetCurrency returns getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryDailyAverageCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// getEnvironmentEnvironmentCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type getEnvironmentEnvironmentCostCostSummaryLastDayCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns getEnvironmentEnvironmentCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryLastDayCostSample) GetAmount() float64 {
+	return v.Amount
+}
+
+// GetCurrency returns getEnvironmentEnvironmentCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryLastDayCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample) GetAmount() float64 {
+	return v.Amount
+}
+
+// GetCurrency returns getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryLastMonthCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
+	return v.Amount
+}
+
+// GetCurrency returns getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// getEnvironmentEnvironmentDeletable includes the requested fields of the GraphQL type Deletable.
+// The GraphQL type's documentation follows.
+//
+// Lifecycle check indicating whether a resource can safely be deleted.
+//
+// Before deleting a project or environment, query this field to determine if deletion is
+// allowed. When `result` is `false`, the `constraints` list explains exactly what is
+// blocking deletion and which resources need to be resolved first.
+//
+// A resource is deletable only when **all** of the following are true:
+// - No child instances are provisioned or in a failed state
+// - No deployments are currently pending or running
+// - No dependent child resources exist (e.g., environments in a project, forks of an environment)
+type getEnvironmentEnvironmentDeletable struct {
+	// Whether the resource can be safely deleted right now.
+	Result bool `json:"result"`
+	// The list of conditions preventing deletion. Empty when `result` is `true`.
+	Constraints []getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint `json:"constraints"`
+}
+
+// GetResult returns getEnvironmentEnvironmentDeletable.Result, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentDeletable) GetResult() bool { return v.Result }
+
+// GetConstraints returns getEnvironmentEnvironmentDeletable.Constraints, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentDeletable) GetConstraints() []getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint {
+	return v.Constraints
+}
+
+// getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint includes the requested fields of the GraphQL type DeletionConstraint.
+// The GraphQL type's documentation follows.
+//
+// A specific condition that prevents a resource from being deleted.
+//
+// Each constraint identifies the **blocking resource** (by type and id) and provides a
+// human-readable message explaining what must be resolved before deletion can proceed.
+// For example, an environment cannot be deleted while it contains provisioned instances,
+// and a project cannot be deleted while it has environments.
+//
+// To resolve a constraint, address the blocking condition described in `message` -- typically
+// by decommissioning or removing the resource identified by `type` and `id`.
+type getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint struct {
+	// Human-readable explanation of why deletion is blocked.
+	Message string `json:"message"`
+	// The kind of resource causing the block (e.g., `instance`, `environment`).
+	Type string `json:"type"`
+	// The identifier of the blocking resource.
+	Id string `json:"id"`
+}
+
+// GetMessage returns getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint.Message, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint) GetMessage() string {
+	return v.Message
+}
+
+// GetType returns getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint.Type, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint) GetType() string {
+	return v.Type
+}
+
+// GetId returns getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentDeletableConstraintsDeletionConstraint) GetId() string { return v.Id }
+
+// getEnvironmentEnvironmentProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type getEnvironmentEnvironmentProject struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getEnvironmentEnvironmentProject.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentProject) GetId() string { return v.Id }
+
+// getEnvironmentResponse is returned by getEnvironment on success.
+type getEnvironmentResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment getEnvironmentEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns getEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *getEnvironmentResponse) GetEnvironment() getEnvironmentEnvironment { return v.Environment }
+
+// getInstanceAlarmInstanceAlarm includes the requested fields of the GraphQL type Alarm.
+// The GraphQL type's documentation follows.
+//
+// A cloud metric alarm attached to an instance.
+//
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
+//
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type getInstanceAlarmInstanceAlarm struct {
+	// Unique identifier for this alarm.
+	Id string `json:"id"`
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
+	CloudResourceId string `json:"cloudResourceId"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
+	ComparisonOperator string `json:"comparisonOperator"`
+	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
+	Threshold float64 `json:"threshold"`
+	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
+	Period int `json:"period"`
+	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
+	Metric *getInstanceAlarmInstanceAlarmMetric `json:"metric"`
+}
+
+// GetId returns getInstanceAlarmInstanceAlarm.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetId() string { return v.Id }
+
+// GetDisplayName returns getInstanceAlarmInstanceAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetDisplayName() string { return v.DisplayName }
+
+// GetCloudResourceId returns getInstanceAlarmInstanceAlarm.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetCloudResourceId() string { return v.CloudResourceId }
+
+// GetComparisonOperator returns getInstanceAlarmInstanceAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetComparisonOperator() string { return v.ComparisonOperator }
+
+// GetThreshold returns getInstanceAlarmInstanceAlarm.Threshold, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetThreshold() float64 { return v.Threshold }
+
+// GetPeriod returns getInstanceAlarmInstanceAlarm.Period, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetPeriod() int { return v.Period }
+
+// GetMetric returns getInstanceAlarmInstanceAlarm.Metric, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarm) GetMetric() *getInstanceAlarmInstanceAlarmMetric {
+	return v.Metric
+}
+
+// getInstanceAlarmInstanceAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// The GraphQL type's documentation follows.
+//
+// The cloud metric an alarm is evaluating.
+//
+// Shape and populated fields vary by provider. AWS and Azure populate
+// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
+// are populated when the provider exposes them as structured key-value pairs.
+type getInstanceAlarmInstanceAlarmMetric struct {
+	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
+	Namespace string `json:"namespace"`
+	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+	Name string `json:"name"`
+	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
+	Statistic string `json:"statistic"`
+	// Cloud region this metric is scoped to, when provider-reported.
+	Region string `json:"region"`
+	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
+	Dimensions []getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+}
+
+// GetNamespace returns getInstanceAlarmInstanceAlarmMetric.Namespace, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetric) GetNamespace() string { return v.Namespace }
+
+// GetName returns getInstanceAlarmInstanceAlarmMetric.Name, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetric) GetName() string { return v.Name }
+
+// GetStatistic returns getInstanceAlarmInstanceAlarmMetric.Statistic, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetric) GetStatistic() string { return v.Statistic }
+
+// GetRegion returns getInstanceAlarmInstanceAlarmMetric.Region, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetric) GetRegion() string { return v.Region }
+
+// GetDimensions returns getInstanceAlarmInstanceAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetric) GetDimensions() []getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension {
+	return v.Dimensions
+}
+
+// getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
+// The GraphQL type's documentation follows.
+//
+// A key-value pair identifying the specific cloud resource a metric applies to.
+//
+// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
+// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
+type getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension struct {
+	// Dimension name as defined by the cloud provider.
+	Name string `json:"name"`
+	// Dimension value identifying the monitored resource.
+	Value string `json:"value"`
+}
+
+// GetName returns getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
+	return v.Name
+}
+
+// GetValue returns getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
+	return v.Value
+}
+
+// getInstanceAlarmResponse is returned by getInstanceAlarm on success.
+type getInstanceAlarmResponse struct {
+	// Fetch a single alarm by its unique identifier.
+	//
+	// Returns the alarm with its most recent `currentState` attached, or a
+	// `NOT_FOUND` error if the alarm doesn't exist or its project isn't
+	// visible to the caller.
+	//
+	// ```graphql
+	// query {
+	// instanceAlarm(organizationId: "my-org", id: "0192…uuid") {
+	// id
+	// displayName
+	// currentState { status occurredAt }
+	// metric { namespace name }
+	// }
+	// }
+	// ```
+	InstanceAlarm getInstanceAlarmInstanceAlarm `json:"instanceAlarm"`
+}
+
+// GetInstanceAlarm returns getInstanceAlarmResponse.InstanceAlarm, and is useful for accessing the field via an interface.
+func (v *getInstanceAlarmResponse) GetInstanceAlarm() getInstanceAlarmInstanceAlarm {
+	return v.InstanceAlarm
+}
+
+// getInstanceDependenciesInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type getInstanceDependenciesInstance struct {
+	Id string `json:"id"`
+	// Dependencies wired into this instance's bundle slots, sorted alphabetically by field.
+	//
+	// Each entry is one filled slot from the bundle's `connections_schema` along
+	// with the source object that filled it — a blueprint `Connection`, a
+	// per-instance `RemoteReference`, or an `EnvironmentDefault` from the
+	// environment. Unfilled slots are not included.
+	Dependencies []getInstanceDependenciesInstanceDependenciesInstanceDependency `json:"dependencies"`
+}
+
+// GetId returns getInstanceDependenciesInstance.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstance) GetId() string { return v.Id }
+
+// GetDependencies returns getInstanceDependenciesInstance.Dependencies, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstance) GetDependencies() []getInstanceDependenciesInstanceDependenciesInstanceDependency {
+	return v.Dependencies
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependency includes the requested fields of the GraphQL type InstanceDependency.
+// The GraphQL type's documentation follows.
+//
+// An input dependency consumed by an instance, keyed by the field handle that receives it.
+//
+// Dependencies are resources wired into this instance's bundle slots — either
+// through a blueprint connection, a per-instance remote-reference override, or
+// the environment's default for the resource type.
+type getInstanceDependenciesInstanceDependenciesInstanceDependency struct {
+	// The input handle name that consumes this resource (e.g., `database`).
+	Field string `json:"field"`
+	// The resource containing the actual data.
+	Resource getInstanceDependenciesInstanceDependenciesInstanceDependencyResource `json:"resource"`
+	// Where this slot's wire-in comes from. Inspect the concrete type — `Connection`, `RemoteReference`, or `EnvironmentDefault` — to distinguish.
+	Source getInstanceDependenciesInstanceDependenciesInstanceDependencySource `json:"-"`
+}
+
+// GetField returns getInstanceDependenciesInstanceDependenciesInstanceDependency.Field, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) GetField() string {
+	return v.Field
+}
+
+// GetResource returns getInstanceDependenciesInstanceDependenciesInstanceDependency.Resource, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) GetResource() getInstanceDependenciesInstanceDependenciesInstanceDependencyResource {
+	return v.Resource
+}
+
+// GetSource returns getInstanceDependenciesInstanceDependenciesInstanceDependency.Source, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) GetSource() getInstanceDependenciesInstanceDependenciesInstanceDependencySource {
+	return v.Source
+}
+
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInstanceDependenciesInstanceDependenciesInstanceDependency
+		Source json.RawMessage `json:"source"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInstanceDependenciesInstanceDependenciesInstanceDependency = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Source
+		src := firstPass.Source
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetInstanceDependenciesInstanceDependenciesInstanceDependencySource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getInstanceDependenciesInstanceDependenciesInstanceDependency.Source: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetInstanceDependenciesInstanceDependenciesInstanceDependency struct {
+	Field string `json:"field"`
+
+	Resource getInstanceDependenciesInstanceDependenciesInstanceDependencyResource `json:"resource"`
+
+	Source json.RawMessage `json:"source"`
+}
+
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependency) __premarshalJSON() (*__premarshalgetInstanceDependenciesInstanceDependenciesInstanceDependency, error) {
+	var retval __premarshalgetInstanceDependenciesInstanceDependenciesInstanceDependency
+
+	retval.Field = v.Field
+	retval.Resource = v.Resource
+	{
+
+		dst := &retval.Source
+		src := v.Source
+		var err error
+		*dst, err = __marshalgetInstanceDependenciesInstanceDependenciesInstanceDependencySource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getInstanceDependenciesInstanceDependenciesInstanceDependency.Source: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencyResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencyResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+}
+
+// GetId returns getInstanceDependenciesInstanceDependenciesInstanceDependencyResource.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencyResource) GetId() string {
+	return v.Id
+}
+
+// GetName returns getInstanceDependenciesInstanceDependenciesInstanceDependencyResource.Name, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencyResource) GetName() string {
+	return v.Name
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySource includes the requested fields of the GraphQL interface InstanceDependencySource.
+//
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySource is implemented by the following types:
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference
+// The GraphQL type's documentation follows.
+//
+// Where a dependency wire-in comes from.
+//
+// - `Connection` — the wire was drawn from a blueprint Link between two
+// components in this project.
+// - `RemoteReference` — the wire is a per-instance override pointing at a
+// resource from another project (or an imported resource).
+// - `EnvironmentDefault` — no explicit wire was set, so the slot is filled
+// from the environment's default for this resource type.
+//
+// Per-instance `RemoteReference` overrides take priority over blueprint
+// `Connection`s, which take priority over `EnvironmentDefault`s.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencySource interface {
+	implementsGraphQLInterfacegetInstanceDependenciesInstanceDependenciesInstanceDependencySource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection) implementsGraphQLInterfacegetInstanceDependenciesInstanceDependenciesInstanceDependencySource() {
+}
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault) implementsGraphQLInterfacegetInstanceDependenciesInstanceDependenciesInstanceDependencySource() {
+}
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference) implementsGraphQLInterfacegetInstanceDependenciesInstanceDependenciesInstanceDependencySource() {
+}
+
+func __unmarshalgetInstanceDependenciesInstanceDependenciesInstanceDependencySource(b []byte, v *getInstanceDependenciesInstanceDependenciesInstanceDependencySource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Connection":
+		*v = new(getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection)
+		return json.Unmarshal(b, *v)
+	case "EnvironmentDefault":
+		*v = new(getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault)
+		return json.Unmarshal(b, *v)
+	case "RemoteReference":
+		*v = new(getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing InstanceDependencySource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getInstanceDependenciesInstanceDependenciesInstanceDependencySource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetInstanceDependenciesInstanceDependenciesInstanceDependencySource(v *getInstanceDependenciesInstanceDependenciesInstanceDependencySource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection:
+		typename = "Connection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection
+		}{typename, v}
+		return json.Marshal(result)
+	case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault:
+		typename = "EnvironmentDefault"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault
+		}{typename, v}
+		return json.Marshal(result)
+	case *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference:
+		typename = "RemoteReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getInstanceDependenciesInstanceDependenciesInstanceDependencySource: "%T"`, v)
+	}
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection includes the requested fields of the GraphQL type Connection.
+// The GraphQL type's documentation follows.
+//
+// A runtime wiring between two instances in an environment.
+//
+// A connection is the **runtime realization** of a blueprint link. Where a link
+// says "the database component's `authentication` output goes to the app
+// component's `database` input," the connection in each environment carries the
+// *actual* resource data (e.g., a connection string) from the source instance
+// to the destination instance.
+//
+// Connections are created automatically when instances are deployed and a
+// matching blueprint link exists.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this connection.
+	Id string `json:"id"`
+	// The output field name on the source instance that produces the resource.
+	FromField string `json:"fromField"`
+	// The source instance that produces the resource wired through this connection.
+	FromInstance *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance `json:"fromInstance"`
+}
+
+// GetTypename returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection.Typename, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection) GetId() string {
+	return v.Id
+}
+
+// GetFromField returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection.FromField, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection) GetFromField() string {
+	return v.FromField
+}
+
+// GetFromInstance returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection.FromInstance, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnection) GetFromInstance() *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance {
+	return v.FromInstance
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceConnectionFromInstance) GetId() string {
+	return v.Id
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
+// The GraphQL type's documentation follows.
+//
+// An environment default that automatically provides a resource to instances.
+//
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this environment default.
+	Id string `json:"id"`
+}
+
+// GetTypename returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault.Typename, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceEnvironmentDefault) GetId() string {
+	return v.Id
+}
+
+// getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
+//
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
+//
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
+//
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this remote reference.
+	Id string `json:"id"`
+}
+
+// GetTypename returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference.Typename, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesInstanceDependenciesInstanceDependencySourceRemoteReference) GetId() string {
+	return v.Id
+}
+
+// getInstanceDependenciesResponse is returned by getInstanceDependencies on success.
+type getInstanceDependenciesResponse struct {
+	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
+	Instance getInstanceDependenciesInstance `json:"instance"`
+}
+
+// GetInstance returns getInstanceDependenciesResponse.Instance, and is useful for accessing the field via an interface.
+func (v *getInstanceDependenciesResponse) GetInstance() getInstanceDependenciesInstance {
+	return v.Instance
+}
+
+// getInstanceInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type getInstanceInstance struct {
+	Id string `json:"id"`
+	// Human-readable display name for the instance.
+	Name string `json:"name"`
+	// Current lifecycle state of the instance.
+	Status InstanceStatus `json:"status"`
+	// Version constraint that controls which bundle releases are eligible. Supports semver constraints like `~1.0`, exact versions like `1.2.3`, or `latest`.
+	Version string `json:"version"`
+	// Whether to include development (pre-release) builds when resolving the version constraint.
+	ReleaseStrategy ReleaseStrategy `json:"releaseStrategy"`
+	// The concrete bundle version resolved from the version constraint and release strategy.
+	//
+	// This is the version that will be used on the **next** deployment. Compare
+	// with `deployedVersion` to determine if a redeployment would change anything.
+	ResolvedVersion string `json:"resolvedVersion"`
+	// The bundle version that was last successfully deployed to infrastructure.
+	//
+	// May differ from `resolvedVersion` if the version constraint has been updated
+	// but no deployment has occurred yet. Null if the instance has never been deployed.
+	DeployedVersion string `json:"deployedVersion"`
+	// The newest bundle version available that satisfies the version constraint.
+	//
+	// Returns null if the instance is already on the latest matching version.
+	// Use this field to detect when an upgrade is available.
+	AvailableUpgrade string `json:"availableUpgrade"`
+	// Cached configuration parameters from the most recent deployment. Null if the instance has never been deployed.
+	Params map[string]any `json:"-"`
+	// Key-value attributes assigned directly to this instance.
+	Attributes map[string]any `json:"-"`
+	// The full attribute map the authorization system evaluates policies against for
+	// this instance — user attributes merged across the hierarchy plus auto-injected
+	// `md-*` system attributes.
+	//
+	// User-attribute merge precedence (higher overrides lower): project > environment > component > instance.
+	//
+	// System attributes always present on an instance:
+	// - `md-id` — the instance's identifier
+	// - `md-project` — the project's identifier
+	// - `md-environment` — the environment's local identifier
+	// - `md-component` — the component's local identifier
+	// - `md-repo` — the bundle's repo name
+	// - `md-bundle` — `"{bundle}@{version}"` of the resolved release
+	EffectiveAttributes map[string]any `json:"-"`
+	// The environment this instance is deployed in.
+	Environment getInstanceInstanceEnvironment `json:"environment"`
+	// The component this instance was deployed from.
+	Component getInstanceInstanceComponent `json:"component"`
+	// Flattened list of scalar leaf values published by this instance's resources.
+	//
+	// Each entry corresponds to one scalar in a resource's payload (e.g. a database
+	// hostname, a queue URL). Entries are drawn from both provisioned resources and
+	// any remote references set on this instance.
+	//
+	// Sensitive fields (marked `$md.sensitive: true` on the resource type's schema)
+	// are returned as `"[SENSITIVE]"`. Paths are jq-style — identifier-safe keys as
+	// `.key`, non-identifier keys quoted (`."app.kubernetes.io/name"`), array
+	// elements as `[n]` (e.g. `.cluster.nodes[0].host`).
+	Properties []getInstanceInstancePropertiesInstanceProperty `json:"properties"`
+}
+
+// GetId returns getInstanceInstance.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetId() string { return v.Id }
+
+// GetName returns getInstanceInstance.Name, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetName() string { return v.Name }
+
+// GetStatus returns getInstanceInstance.Status, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetStatus() InstanceStatus { return v.Status }
+
+// GetVersion returns getInstanceInstance.Version, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetVersion() string { return v.Version }
+
+// GetReleaseStrategy returns getInstanceInstance.ReleaseStrategy, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetReleaseStrategy() ReleaseStrategy { return v.ReleaseStrategy }
+
+// GetResolvedVersion returns getInstanceInstance.ResolvedVersion, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetResolvedVersion() string { return v.ResolvedVersion }
+
+// GetDeployedVersion returns getInstanceInstance.DeployedVersion, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetDeployedVersion() string { return v.DeployedVersion }
+
+// GetAvailableUpgrade returns getInstanceInstance.AvailableUpgrade, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetAvailableUpgrade() string { return v.AvailableUpgrade }
+
+// GetParams returns getInstanceInstance.Params, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetParams() map[string]any { return v.Params }
+
+// GetAttributes returns getInstanceInstance.Attributes, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetAttributes() map[string]any { return v.Attributes }
+
+// GetEffectiveAttributes returns getInstanceInstance.EffectiveAttributes, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetEffectiveAttributes() map[string]any { return v.EffectiveAttributes }
+
+// GetEnvironment returns getInstanceInstance.Environment, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetEnvironment() getInstanceInstanceEnvironment { return v.Environment }
+
+// GetComponent returns getInstanceInstance.Component, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetComponent() getInstanceInstanceComponent { return v.Component }
+
+// GetProperties returns getInstanceInstance.Properties, and is useful for accessing the field via an interface.
+func (v *getInstanceInstance) GetProperties() []getInstanceInstancePropertiesInstanceProperty {
+	return v.Properties
+}
+
+func (v *getInstanceInstance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInstanceInstance
+		Params              json.RawMessage `json:"params"`
+		Attributes          json.RawMessage `json:"attributes"`
+		EffectiveAttributes json.RawMessage `json:"effectiveAttributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInstanceInstance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Params
+		src := firstPass.Params
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getInstanceInstance.Params: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getInstanceInstance.Attributes: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.EffectiveAttributes
+		src := firstPass.EffectiveAttributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getInstanceInstance.EffectiveAttributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetInstanceInstance struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Status InstanceStatus `json:"status"`
+
+	Version string `json:"version"`
+
+	ReleaseStrategy ReleaseStrategy `json:"releaseStrategy"`
+
+	ResolvedVersion string `json:"resolvedVersion"`
+
+	DeployedVersion string `json:"deployedVersion"`
+
+	AvailableUpgrade string `json:"availableUpgrade"`
+
+	Params json.RawMessage `json:"params"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	EffectiveAttributes json.RawMessage `json:"effectiveAttributes"`
+
+	Environment getInstanceInstanceEnvironment `json:"environment"`
+
+	Component getInstanceInstanceComponent `json:"component"`
+
+	Properties []getInstanceInstancePropertiesInstanceProperty `json:"properties"`
+}
+
+func (v *getInstanceInstance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInstanceInstance) __premarshalJSON() (*__premarshalgetInstanceInstance, error) {
+	var retval __premarshalgetInstanceInstance
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Status = v.Status
+	retval.Version = v.Version
+	retval.ReleaseStrategy = v.ReleaseStrategy
+	retval.ResolvedVersion = v.ResolvedVersion
+	retval.DeployedVersion = v.DeployedVersion
+	retval.AvailableUpgrade = v.AvailableUpgrade
+	{
+
+		dst := &retval.Params
+		src := v.Params
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getInstanceInstance.Params: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getInstanceInstance.Attributes: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.EffectiveAttributes
+		src := v.EffectiveAttributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getInstanceInstance.EffectiveAttributes: %w", err)
+		}
+	}
+	retval.Environment = v.Environment
+	retval.Component = v.Component
+	retval.Properties = v.Properties
+	return &retval, nil
+}
+
+// getInstanceInstanceComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type getInstanceInstanceComponent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getInstanceInstanceComponent.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceInstanceComponent) GetId() string { return v.Id }
+
+// getInstanceInstanceEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type getInstanceInstanceEnvironment struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getInstanceInstanceEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getInstanceInstanceEnvironment) GetId() string { return v.Id }
+
+// getInstanceInstancePropertiesInstanceProperty includes the requested fields of the GraphQL type InstanceProperty.
+// The GraphQL type's documentation follows.
+//
+// A flattened leaf value from one of this instance's resources.
+//
+// Each entry is a single scalar produced by the instance's deployments (e.g. a
+// database hostname, a queue URL). Values are drawn from both the instance's own
+// provisioned resources and any remote references wired in.
+//
+// Sensitive fields (marked `$md.sensitive: true` on the resource type's schema)
+// are replaced with `"[SENSITIVE]"`.
+type getInstanceInstancePropertiesInstanceProperty struct {
+	// Display label built from the resource's title and the field's title (e.g. `Database: Hostname`).
+	Name string `json:"name"`
+	// jq-style path to the value from the instance root. Identifier-safe keys render as `.key`; keys with special characters are quoted (`."key.with.dots"`); array elements use `[n]`. Examples: `.database.port`, `.cluster.nodes[0].host`, `.labels."app.kubernetes.io/name"`.
+	Path string `json:"path"`
+	// Scalar value at this path, serialized as a string. Null if the underlying value is null. Sensitive fields appear as `[SENSITIVE]`.
+	Value string `json:"value"`
+}
+
+// GetName returns getInstanceInstancePropertiesInstanceProperty.Name, and is useful for accessing the field via an interface.
+func (v *getInstanceInstancePropertiesInstanceProperty) GetName() string { return v.Name }
+
+// GetPath returns getInstanceInstancePropertiesInstanceProperty.Path, and is useful for accessing the field via an interface.
+func (v *getInstanceInstancePropertiesInstanceProperty) GetPath() string { return v.Path }
+
+// GetValue returns getInstanceInstancePropertiesInstanceProperty.Value, and is useful for accessing the field via an interface.
+func (v *getInstanceInstancePropertiesInstanceProperty) GetValue() string { return v.Value }
+
+// getInstanceResponse is returned by getInstance on success.
+type getInstanceResponse struct {
+	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
+	Instance getInstanceInstance `json:"instance"`
+}
+
+// GetInstance returns getInstanceResponse.Instance, and is useful for accessing the field via an interface.
+func (v *getInstanceResponse) GetInstance() getInstanceInstance { return v.Instance }
+
+// getProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type getProjectProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
+	Name string `json:"name"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
+	Attributes map[string]any `json:"-"`
+	// Whether this project can be safely deleted. Check `constraints` for blocking conditions.
+	Deletable getProjectProjectDeletable `json:"deletable"`
+}
+
+// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetId() string { return v.Id }
+
+// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetName() string { return v.Name }
+
+// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDescription() string { return v.Description }
+
+// GetAttributes returns getProjectProject.Attributes, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDeletable returns getProjectProject.Deletable, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDeletable() getProjectProjectDeletable { return v.Deletable }
+
+func (v *getProjectProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectProject
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getProjectProject.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetProjectProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	Deletable getProjectProjectDeletable `json:"deletable"`
+}
+
+func (v *getProjectProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
+	var retval __premarshalgetProjectProject
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getProjectProject.Attributes: %w", err)
+		}
+	}
+	retval.Deletable = v.Deletable
+	return &retval, nil
+}
+
+// getProjectProjectDeletable includes the requested fields of the GraphQL type Deletable.
+// The GraphQL type's documentation follows.
+//
+// Lifecycle check indicating whether a resource can safely be deleted.
+//
+// Before deleting a project or environment, query this field to determine if deletion is
+// allowed. When `result` is `false`, the `constraints` list explains exactly what is
+// blocking deletion and which resources need to be resolved first.
+//
+// A resource is deletable only when **all** of the following are true:
+// - No child instances are provisioned or in a failed state
+// - No deployments are currently pending or running
+// - No dependent child resources exist (e.g., environments in a project, forks of an environment)
+type getProjectProjectDeletable struct {
+	// Whether the resource can be safely deleted right now.
+	Result bool `json:"result"`
+	// The list of conditions preventing deletion. Empty when `result` is `true`.
+	Constraints []getProjectProjectDeletableConstraintsDeletionConstraint `json:"constraints"`
+}
+
+// GetResult returns getProjectProjectDeletable.Result, and is useful for accessing the field via an interface.
+func (v *getProjectProjectDeletable) GetResult() bool { return v.Result }
+
+// GetConstraints returns getProjectProjectDeletable.Constraints, and is useful for accessing the field via an interface.
+func (v *getProjectProjectDeletable) GetConstraints() []getProjectProjectDeletableConstraintsDeletionConstraint {
+	return v.Constraints
+}
+
+// getProjectProjectDeletableConstraintsDeletionConstraint includes the requested fields of the GraphQL type DeletionConstraint.
+// The GraphQL type's documentation follows.
+//
+// A specific condition that prevents a resource from being deleted.
+//
+// Each constraint identifies the **blocking resource** (by type and id) and provides a
+// human-readable message explaining what must be resolved before deletion can proceed.
+// For example, an environment cannot be deleted while it contains provisioned instances,
+// and a project cannot be deleted while it has environments.
+//
+// To resolve a constraint, address the blocking condition described in `message` -- typically
+// by decommissioning or removing the resource identified by `type` and `id`.
+type getProjectProjectDeletableConstraintsDeletionConstraint struct {
+	// Human-readable explanation of why deletion is blocked.
+	Message string `json:"message"`
+	// The kind of resource causing the block (e.g., `instance`, `environment`).
+	Type string `json:"type"`
+	// The identifier of the blocking resource.
+	Id string `json:"id"`
+}
+
+// GetMessage returns getProjectProjectDeletableConstraintsDeletionConstraint.Message, and is useful for accessing the field via an interface.
+func (v *getProjectProjectDeletableConstraintsDeletionConstraint) GetMessage() string {
+	return v.Message
+}
+
+// GetType returns getProjectProjectDeletableConstraintsDeletionConstraint.Type, and is useful for accessing the field via an interface.
+func (v *getProjectProjectDeletableConstraintsDeletionConstraint) GetType() string { return v.Type }
+
+// GetId returns getProjectProjectDeletableConstraintsDeletionConstraint.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProjectDeletableConstraintsDeletionConstraint) GetId() string { return v.Id }
+
+// getProjectResponse is returned by getProject on success.
+type getProjectResponse struct {
+	// Fetch a single project by its identifier.
+	Project getProjectProject `json:"project"`
+}
+
+// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+
+// getResourceResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type getResourceResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created. Determines whether it can be modified through the API.
+	Origin ResourceOrigin `json:"origin"`
+	// The bundle output handle that produced this resource (e.g., `authentication`, `database`).
+	//
+	// Set only for **provisioned** resources — it corresponds to a field declared under
+	// `artifacts` in the producing bundle's `massdriver.yaml`. Null for **imported** resources.
+	Field string `json:"field"`
+	// Download formats supported for this resource.
+	//
+	// Always includes `json` (the raw payload). Additional formats come from the resource
+	// type's `$md.export` declarations, which can render the payload as YAML or other
+	// templated outputs. Pass a returned format to `downloadArtifact` to retrieve the
+	// rendered content.
+	Formats []string `json:"formats"`
+	// The resource's structured payload. Fields marked `$md.sensitive` in the resource type's
+	// schema are masked as `[SENSITIVE]`. Use `exportResource` to retrieve an unmasked copy —
+	// that operation is recorded in the audit log.
+	Payload map[string]any `json:"-"`
+	// Key-value attributes assigned directly to this resource, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType *getResourceResourceResourceType `json:"resourceType"`
+	// The instance whose deployment produced this resource.
+	//
+	// Null for **imported** resources. For **provisioned** resources, this is the instance
+	// that owns the resource's lifecycle — updating or decommissioning the instance will
+	// update or remove the resource.
+	Instance getResourceResourceInstance `json:"instance"`
+}
+
+// GetId returns getResourceResource.Id, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetId() string { return v.Id }
+
+// GetName returns getResourceResource.Name, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetName() string { return v.Name }
+
+// GetOrigin returns getResourceResource.Origin, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetOrigin() ResourceOrigin { return v.Origin }
+
+// GetField returns getResourceResource.Field, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetField() string { return v.Field }
+
+// GetFormats returns getResourceResource.Formats, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetFormats() []string { return v.Formats }
+
+// GetPayload returns getResourceResource.Payload, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetPayload() map[string]any { return v.Payload }
+
+// GetAttributes returns getResourceResource.Attributes, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetAttributes() map[string]any { return v.Attributes }
+
+// GetResourceType returns getResourceResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetResourceType() *getResourceResourceResourceType {
+	return v.ResourceType
+}
+
+// GetInstance returns getResourceResource.Instance, and is useful for accessing the field via an interface.
+func (v *getResourceResource) GetInstance() getResourceResourceInstance { return v.Instance }
+
+func (v *getResourceResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getResourceResource
+		Payload    json.RawMessage `json:"payload"`
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getResourceResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Payload
+		src := firstPass.Payload
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getResourceResource.Payload: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getResourceResource.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetResourceResource struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Field string `json:"field"`
+
+	Formats []string `json:"formats"`
+
+	Payload json.RawMessage `json:"payload"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	ResourceType *getResourceResourceResourceType `json:"resourceType"`
+
+	Instance getResourceResourceInstance `json:"instance"`
+}
+
+func (v *getResourceResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getResourceResource) __premarshalJSON() (*__premarshalgetResourceResource, error) {
+	var retval __premarshalgetResourceResource
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	retval.Field = v.Field
+	retval.Formats = v.Formats
+	{
+
+		dst := &retval.Payload
+		src := v.Payload
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getResourceResource.Payload: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getResourceResource.Attributes: %w", err)
+		}
+	}
+	retval.ResourceType = v.ResourceType
+	retval.Instance = v.Instance
+	return &retval, nil
+}
+
+// getResourceResourceInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type getResourceResourceInstance struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getResourceResourceInstance.Id, and is useful for accessing the field via an interface.
+func (v *getResourceResourceInstance) GetId() string { return v.Id }
+
+// getResourceResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type getResourceResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns getResourceResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *getResourceResourceResourceType) GetId() string { return v.Id }
+
+// getResourceResponse is returned by getResource on success.
+type getResourceResponse struct {
+	// Fetch a single resource by its unique identifier.
+	//
+	// Returns the full resource record including its origin, resource type, and timestamps.
+	Resource getResourceResource `json:"resource"`
+}
+
+// GetResource returns getResourceResponse.Resource, and is useful for accessing the field via an interface.
+func (v *getResourceResponse) GetResource() getResourceResource { return v.Resource }
+
+// getResourceTypeResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type getResourceTypeResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+	Name string `json:"name"`
+	// URL to the icon representing this resource type, if available.
+	Icon string `json:"icon"`
+	// How instances receive a dependency of this resource type. Determines whether connections are explicit links on the canvas or automatic environment-level defaults.
+	ConnectionOrientation ConnectionOrientation `json:"connectionOrientation"`
+	// The full JSON Schema describing the shape of data this resource type exposes to dependents.
+	//
+	// Use this to generate forms, validate inputs, or inspect the fields available on a connection
+	// of this resource type. The schema is returned verbatim, including Massdriver's `$md` extensions
+	// (e.g., `icon`, `ui`). Callers that only want the data contract can read `properties.data` or
+	// strip `$md` themselves.
+	Schema map[string]any `json:"-"`
+	// UI hints describing how to render the import form for this resource type.
+	//
+	// Follows [react-jsonschema-form](https://rjsf-team.github.io/react-jsonschema-form/)'s
+	// `uiSchema` conventions: keys mirror the `data` schema's structure and values contain
+	// rendering directives (e.g., `ui:widget`, `ui:order`, `ui:help`). Returns an empty
+	// object when the resource type does not provide UI hints.
+	UiSchema map[string]any `json:"-"`
+	// Step-by-step import instructions, typically one entry per workflow (CLI, console, etc.).
+	//
+	// Each entry is rendered as its own tab or section so users can pick the workflow they
+	// prefer when importing an existing resource. Returns an empty list when the resource
+	// type does not provide instructions.
+	Instructions []getResourceTypeResourceTypeInstructionsImportInstruction `json:"instructions"`
+}
+
+// GetId returns getResourceTypeResourceType.Id, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetId() string { return v.Id }
+
+// GetName returns getResourceTypeResourceType.Name, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetName() string { return v.Name }
+
+// GetIcon returns getResourceTypeResourceType.Icon, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetIcon() string { return v.Icon }
+
+// GetConnectionOrientation returns getResourceTypeResourceType.ConnectionOrientation, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetConnectionOrientation() ConnectionOrientation {
+	return v.ConnectionOrientation
+}
+
+// GetSchema returns getResourceTypeResourceType.Schema, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetSchema() map[string]any { return v.Schema }
+
+// GetUiSchema returns getResourceTypeResourceType.UiSchema, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetUiSchema() map[string]any { return v.UiSchema }
+
+// GetInstructions returns getResourceTypeResourceType.Instructions, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceType) GetInstructions() []getResourceTypeResourceTypeInstructionsImportInstruction {
+	return v.Instructions
+}
+
+func (v *getResourceTypeResourceType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getResourceTypeResourceType
+		Schema   json.RawMessage `json:"schema"`
+		UiSchema json.RawMessage `json:"uiSchema"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getResourceTypeResourceType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Schema
+		src := firstPass.Schema
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getResourceTypeResourceType.Schema: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.UiSchema
+		src := firstPass.UiSchema
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getResourceTypeResourceType.UiSchema: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetResourceTypeResourceType struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Icon string `json:"icon"`
+
+	ConnectionOrientation ConnectionOrientation `json:"connectionOrientation"`
+
+	Schema json.RawMessage `json:"schema"`
+
+	UiSchema json.RawMessage `json:"uiSchema"`
+
+	Instructions []getResourceTypeResourceTypeInstructionsImportInstruction `json:"instructions"`
+}
+
+func (v *getResourceTypeResourceType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getResourceTypeResourceType) __premarshalJSON() (*__premarshalgetResourceTypeResourceType, error) {
+	var retval __premarshalgetResourceTypeResourceType
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Icon = v.Icon
+	retval.ConnectionOrientation = v.ConnectionOrientation
+	{
+
+		dst := &retval.Schema
+		src := v.Schema
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getResourceTypeResourceType.Schema: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.UiSchema
+		src := v.UiSchema
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getResourceTypeResourceType.UiSchema: %w", err)
+		}
+	}
+	retval.Instructions = v.Instructions
+	return &retval, nil
+}
+
+// getResourceTypeResourceTypeInstructionsImportInstruction includes the requested fields of the GraphQL type ImportInstruction.
+// The GraphQL type's documentation follows.
+//
+// A single set of import instructions for a resource type, typically rendered as a tab.
+//
+// Resource types may ship multiple instruction variants (e.g., one for the CLI and one
+// for the cloud console) so users can pick the workflow they prefer when importing an
+// existing resource. The `label` is the tab heading; the `content` is the markdown body.
+type getResourceTypeResourceTypeInstructionsImportInstruction struct {
+	// Short heading shown above this instruction set (e.g., "AWS CLI", "AWS Console").
+	Label string `json:"label"`
+	// Markdown body of the instructions. Already decoded from any base64 transport encoding.
+	Content string `json:"content"`
+}
+
+// GetLabel returns getResourceTypeResourceTypeInstructionsImportInstruction.Label, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceTypeInstructionsImportInstruction) GetLabel() string { return v.Label }
+
+// GetContent returns getResourceTypeResourceTypeInstructionsImportInstruction.Content, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResourceTypeInstructionsImportInstruction) GetContent() string {
+	return v.Content
+}
+
+// getResourceTypeResponse is returned by getResourceType on success.
+type getResourceTypeResponse struct {
+	// Fetch a single resource type by its identifier.
+	//
+	// Returns `null` with a `NOT_FOUND` error if the resource type does not exist
+	// or is not accessible to your organization.
+	//
+	// ```graphql
+	// query {
+	// resourceType(organizationId: "your-org-id", id: "aws-iam-role") {
+	// id
+	// name
+	// connectionOrientation
+	// icon
+	// }
+	// }
+	// ```
+	ResourceType getResourceTypeResourceType `json:"resourceType"`
+}
+
+// GetResourceType returns getResourceTypeResponse.ResourceType, and is useful for accessing the field via an interface.
+func (v *getResourceTypeResponse) GetResourceType() getResourceTypeResourceType {
+	return v.ResourceType
+}
+
+// linkComponentsLinkComponentsLinkPayload includes the requested fields of the GraphQL type LinkPayload.
+type linkComponentsLinkComponentsLinkPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result linkComponentsLinkComponentsLinkPayloadResultLink `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns linkComponentsLinkComponentsLinkPayload.Result, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayload) GetResult() linkComponentsLinkComponentsLinkPayloadResultLink {
+	return v.Result
+}
+
+// GetSuccessful returns linkComponentsLinkComponentsLinkPayload.Successful, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns linkComponentsLinkComponentsLinkPayload.Messages, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayload) GetMessages() []linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// linkComponentsLinkComponentsLinkPayloadResultLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type linkComponentsLinkComponentsLinkPayloadResultLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
+}
+
+// GetId returns linkComponentsLinkComponentsLinkPayloadResultLink.Id, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadResultLink) GetId() string { return v.Id }
+
+// GetFromField returns linkComponentsLinkComponentsLinkPayloadResultLink.FromField, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadResultLink) GetFromField() string { return v.FromField }
+
+// GetToField returns linkComponentsLinkComponentsLinkPayloadResultLink.ToField, and is useful for accessing the field via an interface.
+func (v *linkComponentsLinkComponentsLinkPayloadResultLink) GetToField() string { return v.ToField }
+
+// linkComponentsResponse is returned by linkComponents on success.
+type linkComponentsResponse struct {
+	// Create a link between two components.
+	//
+	// Declares that the `fromField` output of one component should be wired to the
+	// `toField` input of another. Massdriver validates that the resource types are
+	// compatible before creating the link. When environments are deployed, each link
+	// becomes a **connection** that carries the actual resource data between instances.
+	//
+	// ```graphql
+	// mutation {
+	// linkComponents(
+	// organizationId: "my-org"
+	// input: {
+	// fromComponentId: "my-project-database"
+	// fromField: "authentication"
+	// fromVersion: "~1.0"
+	// toComponentId: "my-project-app"
+	// toField: "database"
+	// toVersion: "~2.0"
+	// }
+	// ) {
+	// result { id fromField toField }
+	// successful
+	// }
+	// }
+	// ```
+	LinkComponents linkComponentsLinkComponentsLinkPayload `json:"linkComponents"`
+}
+
+// GetLinkComponents returns linkComponentsResponse.LinkComponents, and is useful for accessing the field via an interface.
+func (v *linkComponentsResponse) GetLinkComponents() linkComponentsLinkComponentsLinkPayload {
+	return v.LinkComponents
+}
+
+// listInstanceAlarmsInstanceAlarmsAlarmsPage includes the requested fields of the GraphQL type AlarmsPage.
+type listInstanceAlarmsInstanceAlarmsAlarmsPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type alarm.
+	Items []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm `json:"items"`
+}
+
+// GetCursor returns listInstanceAlarmsInstanceAlarmsAlarmsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPage) GetCursor() listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor {
+	return v.Cursor
+}
+
+// GetItems returns listInstanceAlarmsInstanceAlarmsAlarmsPage.Items, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPage) GetItems() []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm {
+	return v.Items
+}
+
+// listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+}
+
+// GetNext returns listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
+
+// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm includes the requested fields of the GraphQL type Alarm.
+// The GraphQL type's documentation follows.
+//
+// A cloud metric alarm attached to an instance.
+//
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
+//
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm struct {
+	// Unique identifier for this alarm.
+	Id string `json:"id"`
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
+	CloudResourceId string `json:"cloudResourceId"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
+	ComparisonOperator string `json:"comparisonOperator"`
+	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
+	Threshold float64 `json:"threshold"`
+	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
+	Period int `json:"period"`
+	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
+	Metric *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric `json:"metric"`
+}
+
+// GetId returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Id, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetId() string { return v.Id }
+
+// GetDisplayName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetDisplayName() string {
+	return v.DisplayName
+}
+
+// GetCloudResourceId returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetCloudResourceId() string {
+	return v.CloudResourceId
+}
+
+// GetComparisonOperator returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetComparisonOperator() string {
+	return v.ComparisonOperator
+}
+
+// GetThreshold returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Threshold, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetThreshold() float64 {
+	return v.Threshold
+}
+
+// GetPeriod returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Period, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetPeriod() int { return v.Period }
+
+// GetMetric returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm.Metric, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarm) GetMetric() *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric {
+	return v.Metric
+}
+
+// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// The GraphQL type's documentation follows.
+//
+// The cloud metric an alarm is evaluating.
+//
+// Shape and populated fields vary by provider. AWS and Azure populate
+// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
+// are populated when the provider exposes them as structured key-value pairs.
+type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric struct {
+	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
+	Namespace string `json:"namespace"`
+	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+	Name string `json:"name"`
+	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
+	Statistic string `json:"statistic"`
+	// Cloud region this metric is scoped to, when provider-reported.
+	Region string `json:"region"`
+	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
+	Dimensions []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+}
+
+// GetNamespace returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Namespace, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Name, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetName() string { return v.Name }
+
+// GetStatistic returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Statistic, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetStatistic() string {
+	return v.Statistic
+}
+
+// GetRegion returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Region, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetRegion() string {
+	return v.Region
+}
+
+// GetDimensions returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetric) GetDimensions() []listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension {
+	return v.Dimensions
+}
+
+// listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
+// The GraphQL type's documentation follows.
+//
+// A key-value pair identifying the specific cloud resource a metric applies to.
+//
+// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
+// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
+type listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension struct {
+	// Dimension name as defined by the cloud provider.
+	Name string `json:"name"`
+	// Dimension value identifying the monitored resource.
+	Value string `json:"value"`
+}
+
+// GetName returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
+	return v.Name
+}
+
+// GetValue returns listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsInstanceAlarmsAlarmsPageItemsAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
+	return v.Value
+}
+
+// listInstanceAlarmsResponse is returned by listInstanceAlarms on success.
+type listInstanceAlarmsResponse struct {
+	// List alarms across all projects you can see.
+	//
+	// Returns a paginated list. Use `filter` to narrow by project, environment,
+	// component, bundle (`ociRepoName`), or instance. Default sort is
+	// alphabetical by `displayName`.
+	//
+	// ```graphql
+	// query {
+	// instanceAlarms(
+	// organizationId: "my-org"
+	// filter: { environmentId: { eq: "prod" }, ociRepoName: { eq: "aws-rds" } }
+	// ) {
+	// items { id displayName currentState { status occurredAt } }
+	// cursor { next }
+	// }
+	// }
+	// ```
+	InstanceAlarms listInstanceAlarmsInstanceAlarmsAlarmsPage `json:"instanceAlarms"`
+}
+
+// GetInstanceAlarms returns listInstanceAlarmsResponse.InstanceAlarms, and is useful for accessing the field via an interface.
+func (v *listInstanceAlarmsResponse) GetInstanceAlarms() listInstanceAlarmsInstanceAlarmsAlarmsPage {
+	return v.InstanceAlarms
+}
+
+// listResourcesResourcesResourcesPage includes the requested fields of the GraphQL type ResourcesPage.
+type listResourcesResourcesResourcesPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor listResourcesResourcesResourcesPageCursorPaginationCursor `json:"cursor"`
+	// A list of type resource.
+	Items []listResourcesResourcesResourcesPageItemsResource `json:"items"`
+}
+
+// GetCursor returns listResourcesResourcesResourcesPage.Cursor, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPage) GetCursor() listResourcesResourcesResourcesPageCursorPaginationCursor {
+	return v.Cursor
+}
+
+// GetItems returns listResourcesResourcesResourcesPage.Items, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPage) GetItems() []listResourcesResourcesResourcesPageItemsResource {
+	return v.Items
+}
+
+// listResourcesResourcesResourcesPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type listResourcesResourcesResourcesPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+}
+
+// GetNext returns listResourcesResourcesResourcesPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageCursorPaginationCursor) GetNext() string { return v.Next }
+
+// listResourcesResourcesResourcesPageItemsResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type listResourcesResourcesResourcesPageItemsResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created. Determines whether it can be modified through the API.
+	Origin ResourceOrigin `json:"origin"`
+	// The bundle output handle that produced this resource (e.g., `authentication`, `database`).
+	//
+	// Set only for **provisioned** resources — it corresponds to a field declared under
+	// `artifacts` in the producing bundle's `massdriver.yaml`. Null for **imported** resources.
+	Field string `json:"field"`
+	// Key-value attributes assigned directly to this resource, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType *listResourcesResourcesResourcesPageItemsResourceResourceType `json:"resourceType"`
+}
+
+// GetId returns listResourcesResourcesResourcesPageItemsResource.Id, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetId() string { return v.Id }
+
+// GetName returns listResourcesResourcesResourcesPageItemsResource.Name, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetName() string { return v.Name }
+
+// GetOrigin returns listResourcesResourcesResourcesPageItemsResource.Origin, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetOrigin() ResourceOrigin {
+	return v.Origin
+}
+
+// GetField returns listResourcesResourcesResourcesPageItemsResource.Field, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetField() string { return v.Field }
+
+// GetAttributes returns listResourcesResourcesResourcesPageItemsResource.Attributes, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+// GetResourceType returns listResourcesResourcesResourcesPageItemsResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResource) GetResourceType() *listResourcesResourcesResourcesPageItemsResourceResourceType {
+	return v.ResourceType
+}
+
+func (v *listResourcesResourcesResourcesPageItemsResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listResourcesResourcesResourcesPageItemsResource
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listResourcesResourcesResourcesPageItemsResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal listResourcesResourcesResourcesPageItemsResource.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshallistResourcesResourcesResourcesPageItemsResource struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Field string `json:"field"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	ResourceType *listResourcesResourcesResourcesPageItemsResourceResourceType `json:"resourceType"`
+}
+
+func (v *listResourcesResourcesResourcesPageItemsResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listResourcesResourcesResourcesPageItemsResource) __premarshalJSON() (*__premarshallistResourcesResourcesResourcesPageItemsResource, error) {
+	var retval __premarshallistResourcesResourcesResourcesPageItemsResource
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	retval.Field = v.Field
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal listResourcesResourcesResourcesPageItemsResource.Attributes: %w", err)
+		}
+	}
+	retval.ResourceType = v.ResourceType
+	return &retval, nil
+}
+
+// listResourcesResourcesResourcesPageItemsResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type listResourcesResourcesResourcesPageItemsResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns listResourcesResourcesResourcesPageItemsResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *listResourcesResourcesResourcesPageItemsResourceResourceType) GetId() string { return v.Id }
+
+// listResourcesResponse is returned by listResources on success.
+type listResourcesResponse struct {
+	// List all resources in your organization.
+	//
+	// Returns a cursor-paginated list of both imported and provisioned resources.
+	// Use the `filter` argument to view only imported or only provisioned resources.
+	//
+	// ```graphql
+	// query {
+	// resources(organizationId: "my-org", filter: { origin: { eq: imported } }) {
+	// items {
+	// id
+	// name
+	// origin
+	// resourceType { id }
+	// }
+	// cursor { next }
+	// }
+	// }
+	// ```
+	Resources listResourcesResourcesResourcesPage `json:"resources"`
+}
+
+// GetResources returns listResourcesResponse.Resources, and is useful for accessing the field via an interface.
+func (v *listResourcesResponse) GetResources() listResourcesResourcesResourcesPage {
+	return v.Resources
+}
+
+// publishResourceTypePublishResourceTypeResourceTypePayload includes the requested fields of the GraphQL type ResourceTypePayload.
+type publishResourceTypePublishResourceTypeResourceTypePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns publishResourceTypePublishResourceTypeResourceTypePayload.Result, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayload) GetResult() publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType {
+	return v.Result
+}
+
+// GetSuccessful returns publishResourceTypePublishResourceTypeResourceTypePayload.Successful, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns publishResourceTypePublishResourceTypeResourceTypePayload.Messages, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayload) GetMessages() []publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+	Name string `json:"name"`
+	// The full JSON Schema describing the shape of data this resource type exposes to dependents.
+	//
+	// Use this to generate forms, validate inputs, or inspect the fields available on a connection
+	// of this resource type. The schema is returned verbatim, including Massdriver's `$md` extensions
+	// (e.g., `icon`, `ui`). Callers that only want the data contract can read `properties.data` or
+	// strip `$md` themselves.
+	Schema map[string]any `json:"-"`
+}
+
+// GetId returns publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType.Id, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) GetId() string {
+	return v.Id
+}
+
+// GetName returns publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType.Name, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) GetName() string {
+	return v.Name
+}
+
+// GetSchema returns publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType.Schema, and is useful for accessing the field via an interface.
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) GetSchema() map[string]any {
+	return v.Schema
+}
+
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType
+		Schema json.RawMessage `json:"schema"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Schema
+		src := firstPass.Schema
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType.Schema: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalpublishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Schema json.RawMessage `json:"schema"`
+}
+
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType) __premarshalJSON() (*__premarshalpublishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType, error) {
+	var retval __premarshalpublishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Schema
+		src := v.Schema
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal publishResourceTypePublishResourceTypeResourceTypePayloadResultResourceType.Schema: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// publishResourceTypeResponse is returned by publishResourceType on success.
+type publishResourceTypeResponse struct {
+	// **Deprecated — use at your own risk.** This mutation exists only to bridge V0's
+	// `publishArtifactDefinition` into V2 while resource types are being migrated to OCI.
+	// New integrations should use the OCI-native publishing flow. This mutation may be
+	// removed or change behavior without notice.
+	//
+	// Upsert a resource type from a JSON Schema document. If an existing resource type in
+	// your organization has the same `$md.name`, its schema is replaced; otherwise a new
+	// resource type is created.
+	//
+	// The schema describes the shape of data this resource type exposes to dependents. It
+	// must include a `$md` extension that declares the type's identifier (`$md.name`),
+	// display label, icon, and UI behavior.
+	//
+	// ```graphql
+	// mutation {
+	// publishResourceType(
+	// organizationId: "your-org-id"
+	// input: {
+	// schema: {
+	// "$md": { name: "aws-iam-role", label: "AWS IAM Role" }
+	// type: "object"
+	// properties: { data: { type: "object", required: ["arn"], properties: { arn: { type: "string" } } } }
+	// }
+	// }
+	// ) {
+	// successful
+	// result { id name }
+	// messages { field message }
+	// }
+	// }
+	// ```
+	PublishResourceType publishResourceTypePublishResourceTypeResourceTypePayload `json:"publishResourceType"`
+}
+
+// GetPublishResourceType returns publishResourceTypeResponse.PublishResourceType, and is useful for accessing the field via an interface.
+func (v *publishResourceTypeResponse) GetPublishResourceType() publishResourceTypePublishResourceTypeResourceTypePayload {
+	return v.PublishResourceType
+}
+
+// removeComponentRemoveComponentComponentPayload includes the requested fields of the GraphQL type ComponentPayload.
+type removeComponentRemoveComponentComponentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result removeComponentRemoveComponentComponentPayloadResultComponent `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []removeComponentRemoveComponentComponentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns removeComponentRemoveComponentComponentPayload.Result, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayload) GetResult() removeComponentRemoveComponentComponentPayloadResultComponent {
+	return v.Result
+}
+
+// GetSuccessful returns removeComponentRemoveComponentComponentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns removeComponentRemoveComponentComponentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayload) GetMessages() []removeComponentRemoveComponentComponentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// removeComponentRemoveComponentComponentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type removeComponentRemoveComponentComponentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns removeComponentRemoveComponentComponentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns removeComponentRemoveComponentComponentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns removeComponentRemoveComponentComponentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// removeComponentRemoveComponentComponentPayloadResultComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type removeComponentRemoveComponentComponentPayloadResultComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
+
+// GetId returns removeComponentRemoveComponentComponentPayloadResultComponent.Id, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayloadResultComponent) GetId() string { return v.Id }
+
+// GetName returns removeComponentRemoveComponentComponentPayloadResultComponent.Name, and is useful for accessing the field via an interface.
+func (v *removeComponentRemoveComponentComponentPayloadResultComponent) GetName() string {
+	return v.Name
+}
+
+// removeComponentResponse is returned by removeComponent on success.
+type removeComponentResponse struct {
+	// Remove a component from a project's blueprint.
+	//
+	// Deletes the component and all of its links. Any instances deployed from
+	// this component must be decommissioned first.
+	RemoveComponent removeComponentRemoveComponentComponentPayload `json:"removeComponent"`
+}
+
+// GetRemoveComponent returns removeComponentResponse.RemoveComponent, and is useful for accessing the field via an interface.
+func (v *removeComponentResponse) GetRemoveComponent() removeComponentRemoveComponentComponentPayload {
+	return v.RemoveComponent
+}
+
+// removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload includes the requested fields of the GraphQL type EnvironmentDefaultPayload.
+type removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload.Result, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload) GetResult() removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault {
+	return v.Result
+}
+
+// GetSuccessful returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload.Successful, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload.Messages, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload) GetMessages() []removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
+// The GraphQL type's documentation follows.
+//
+// An environment default that automatically provides a resource to instances.
+//
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault struct {
+	// Unique identifier for this environment default.
+	Id string `json:"id"`
+}
+
+// GetId returns removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault.Id, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault) GetId() string {
+	return v.Id
+}
+
+// removeEnvironmentDefaultResponse is returned by removeEnvironmentDefault on success.
+type removeEnvironmentDefaultResponse struct {
+	// Remove an environment default.
+	//
+	// Instances will no longer automatically inherit this resource. **Warning:** removing
+	// a default can cause future deployments to fail if instances depend on the resource
+	// type that was provided by this default.
+	RemoveEnvironmentDefault removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload `json:"removeEnvironmentDefault"`
+}
+
+// GetRemoveEnvironmentDefault returns removeEnvironmentDefaultResponse.RemoveEnvironmentDefault, and is useful for accessing the field via an interface.
+func (v *removeEnvironmentDefaultResponse) GetRemoveEnvironmentDefault() removeEnvironmentDefaultRemoveEnvironmentDefaultEnvironmentDefaultPayload {
+	return v.RemoveEnvironmentDefault
+}
+
+// removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload includes the requested fields of the GraphQL type RemoteReferencePayload.
+type removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Result, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetResult() removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference {
+	return v.Result
+}
+
+// GetSuccessful returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Successful, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Messages, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetMessages() []removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
+//
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
+//
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
+//
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference struct {
+	// Unique identifier for this remote reference.
+	Id string `json:"id"`
+	// The name of the resource field on the instance that this reference satisfies (e.g., `aws_authentication` or `vpc`).
+	Field string `json:"field"`
+}
+
+// GetId returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetId() string {
+	return v.Id
+}
+
+// GetField returns removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference.Field, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetField() string {
+	return v.Field
+}
+
+// removeRemoteReferenceResponse is returned by removeRemoteReference on success.
+type removeRemoteReferenceResponse struct {
+	// Remove a per-instance remote-reference override. The slot reverts to its
+	// blueprint Link (if any) or the environment default at the next deploy.
+	//
+	// The instance must **not** be in `PROVISIONED` or `FAILED` status — taking
+	// an override off a deployed instance would change the resolved connection
+	// map under the running deployment.
+	//
+	// ```graphql
+	// mutation {
+	// removeRemoteReference(
+	// organizationId: "my-org"
+	// instanceId: "my-app"
+	// input: { field: "aws_authentication" }
+	// ) {
+	// result { id field }
+	// successful
+	// messages { field message }
+	// }
+	// }
+	// ```
+	RemoveRemoteReference removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload `json:"removeRemoteReference"`
+}
+
+// GetRemoveRemoteReference returns removeRemoteReferenceResponse.RemoveRemoteReference, and is useful for accessing the field via an interface.
+func (v *removeRemoteReferenceResponse) GetRemoveRemoteReference() removeRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload {
+	return v.RemoveRemoteReference
+}
+
+// setComponentPositionResponse is returned by setComponentPosition on success.
+type setComponentPositionResponse struct {
+	// Set the pixel position of a component on the visual canvas.
+	SetComponentPosition setComponentPositionSetComponentPositionComponentPayload `json:"setComponentPosition"`
+}
+
+// GetSetComponentPosition returns setComponentPositionResponse.SetComponentPosition, and is useful for accessing the field via an interface.
+func (v *setComponentPositionResponse) GetSetComponentPosition() setComponentPositionSetComponentPositionComponentPayload {
+	return v.SetComponentPosition
+}
+
+// setComponentPositionSetComponentPositionComponentPayload includes the requested fields of the GraphQL type ComponentPayload.
+type setComponentPositionSetComponentPositionComponentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result setComponentPositionSetComponentPositionComponentPayloadResultComponent `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns setComponentPositionSetComponentPositionComponentPayload.Result, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayload) GetResult() setComponentPositionSetComponentPositionComponentPayloadResultComponent {
+	return v.Result
+}
+
+// GetSuccessful returns setComponentPositionSetComponentPositionComponentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns setComponentPositionSetComponentPositionComponentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayload) GetMessages() []setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// setComponentPositionSetComponentPositionComponentPayloadResultComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type setComponentPositionSetComponentPositionComponentPayloadResultComponent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns setComponentPositionSetComponentPositionComponentPayloadResultComponent.Id, and is useful for accessing the field via an interface.
+func (v *setComponentPositionSetComponentPositionComponentPayloadResultComponent) GetId() string {
+	return v.Id
+}
+
+// setEnvironmentDefaultResponse is returned by setEnvironmentDefault on success.
+type setEnvironmentDefaultResponse struct {
+	// Set a resource as the default of its type for an environment.
+	//
+	// All instances in the environment that require this resource type will automatically
+	// inherit it. Only one resource per type can be the default -- remove the existing
+	// default first with `removeEnvironmentDefault` to change it.
+	SetEnvironmentDefault setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload `json:"setEnvironmentDefault"`
+}
+
+// GetSetEnvironmentDefault returns setEnvironmentDefaultResponse.SetEnvironmentDefault, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultResponse) GetSetEnvironmentDefault() setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload {
+	return v.SetEnvironmentDefault
+}
+
+// setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload includes the requested fields of the GraphQL type EnvironmentDefaultPayload.
+type setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload.Result, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload) GetResult() setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault {
+	return v.Result
+}
+
+// GetSuccessful returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload.Successful, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload.Messages, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayload) GetMessages() []setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
+// The GraphQL type's documentation follows.
+//
+// An environment default that automatically provides a resource to instances.
+//
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault struct {
+	// Unique identifier for this environment default.
+	Id string `json:"id"`
+	// The resource that is set as the default for its type.
+	Resource setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource `json:"resource"`
+}
+
+// GetId returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault.Id, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault) GetId() string {
+	return v.Id
+}
+
+// GetResource returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault.Resource, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefault) GetResource() setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource {
+	return v.Resource
+}
+
+// setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource includes the requested fields of the GraphQL type EnvironmentDefaultResource.
+// The GraphQL type's documentation follows.
+//
+// A resource referenced by an environment default.
+//
+// This represents the actual cloud resource (e.g., a VPC or DNS zone) that has been
+// designated as the default for its resource type within an environment.
+type setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource struct {
+	// The resource's unique identifier.
+	Id string `json:"id"`
+	// Human-readable name of the resource.
+	Name string `json:"name"`
+	// The resource type (e.g., `massdriver/aws-vpc`) that this resource conforms to.
+	ResourceType *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType `json:"resourceType"`
+}
+
+// GetId returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource.Id, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource) GetId() string {
+	return v.Id
+}
+
+// GetName returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource.Name, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource) GetName() string {
+	return v.Name
+}
+
+// GetResourceType returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResource) GetResourceType() *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType {
+	return v.ResourceType
+}
+
+// setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *setEnvironmentDefaultSetEnvironmentDefaultEnvironmentDefaultPayloadResultEnvironmentDefaultResourceResourceType) GetId() string {
+	return v.Id
+}
+
+// setRemoteReferenceResponse is returned by setRemoteReference on success.
+type setRemoteReferenceResponse struct {
+	// Override one of an instance's connection slots with a resource from another
+	// project (or an imported resource).
+	//
+	// The instance must **not** be in `PROVISIONED` or `FAILED` status — like
+	// other configuration changes, overrides cannot be set on a deployed instance.
+	//
+	// The override takes priority over any blueprint-level Link wired into the
+	// same slot. Removing the override reverts to the Link (or environment default).
+	//
+	// ```graphql
+	// mutation {
+	// setRemoteReference(
+	// organizationId: "my-org"
+	// instanceId: "my-app"
+	// resourceId: "shared-creds-abc123"
+	// input: { field: "aws_authentication" }
+	// ) {
+	// result { id field resource { id name } }
+	// successful
+	// messages { field message }
+	// }
+	// }
+	// ```
+	SetRemoteReference setRemoteReferenceSetRemoteReferenceRemoteReferencePayload `json:"setRemoteReference"`
+}
+
+// GetSetRemoteReference returns setRemoteReferenceResponse.SetRemoteReference, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceResponse) GetSetRemoteReference() setRemoteReferenceSetRemoteReferenceRemoteReferencePayload {
+	return v.SetRemoteReference
+}
+
+// setRemoteReferenceSetRemoteReferenceRemoteReferencePayload includes the requested fields of the GraphQL type RemoteReferencePayload.
+type setRemoteReferenceSetRemoteReferenceRemoteReferencePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Result, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetResult() setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference {
+	return v.Result
+}
+
+// GetSuccessful returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Successful, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Messages, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetMessages() []setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
+//
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
+//
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
+//
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference struct {
+	// Unique identifier for this remote reference.
+	Id string `json:"id"`
+	// The name of the resource field on the instance that this reference satisfies (e.g., `aws_authentication` or `vpc`).
+	Field string `json:"field"`
+	// The resource from another project (or an imported resource) that this reference points to.
+	Resource setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource `json:"resource"`
+}
+
+// GetId returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetId() string {
+	return v.Id
+}
+
+// GetField returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Field, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetField() string {
+	return v.Field
+}
+
+// GetResource returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Resource, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetResource() setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource {
+	return v.Resource
+}
+
+// setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+}
+
+// GetId returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource.Id, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource) GetId() string {
+	return v.Id
+}
+
+// GetName returns setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource.Name, and is useful for accessing the field via an interface.
+func (v *setRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource) GetName() string {
+	return v.Name
+}
+
+// unlinkComponentsResponse is returned by unlinkComponents on success.
+type unlinkComponentsResponse struct {
+	// Remove a link between two components.
+	//
+	// Deletes the design-time dependency. Existing connections in deployed
+	// environments are not affected until the next deployment.
+	UnlinkComponents unlinkComponentsUnlinkComponentsLinkPayload `json:"unlinkComponents"`
+}
+
+// GetUnlinkComponents returns unlinkComponentsResponse.UnlinkComponents, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsResponse) GetUnlinkComponents() unlinkComponentsUnlinkComponentsLinkPayload {
+	return v.UnlinkComponents
+}
+
+// unlinkComponentsUnlinkComponentsLinkPayload includes the requested fields of the GraphQL type LinkPayload.
+type unlinkComponentsUnlinkComponentsLinkPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result unlinkComponentsUnlinkComponentsLinkPayloadResultLink `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns unlinkComponentsUnlinkComponentsLinkPayload.Result, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayload) GetResult() unlinkComponentsUnlinkComponentsLinkPayloadResultLink {
+	return v.Result
+}
+
+// GetSuccessful returns unlinkComponentsUnlinkComponentsLinkPayload.Successful, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns unlinkComponentsUnlinkComponentsLinkPayload.Messages, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayload) GetMessages() []unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// unlinkComponentsUnlinkComponentsLinkPayloadResultLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type unlinkComponentsUnlinkComponentsLinkPayloadResultLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+}
+
+// GetId returns unlinkComponentsUnlinkComponentsLinkPayloadResultLink.Id, and is useful for accessing the field via an interface.
+func (v *unlinkComponentsUnlinkComponentsLinkPayloadResultLink) GetId() string { return v.Id }
+
+// updateComponentResponse is returned by updateComponent on success.
+type updateComponentResponse struct {
+	// Update a component's mutable fields (name, description, attributes).
+	//
+	// The component ID and underlying bundle cannot be changed after creation.
+	// Attributes are validated against the organization's custom-attribute schema
+	// for the component scope.
+	//
+	// ```graphql
+	// mutation {
+	// updateComponent(
+	// organizationId: "my-org"
+	// id: "my-project-database"
+	// input: { name: "Primary Database", description: "User data" }
+	// ) {
+	// result { id name description }
+	// successful
+	// }
+	// }
+	// ```
+	UpdateComponent updateComponentUpdateComponentComponentPayload `json:"updateComponent"`
+}
+
+// GetUpdateComponent returns updateComponentResponse.UpdateComponent, and is useful for accessing the field via an interface.
+func (v *updateComponentResponse) GetUpdateComponent() updateComponentUpdateComponentComponentPayload {
+	return v.UpdateComponent
+}
+
+// updateComponentUpdateComponentComponentPayload includes the requested fields of the GraphQL type ComponentPayload.
+type updateComponentUpdateComponentComponentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result updateComponentUpdateComponentComponentPayloadResultComponent `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []updateComponentUpdateComponentComponentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns updateComponentUpdateComponentComponentPayload.Result, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayload) GetResult() updateComponentUpdateComponentComponentPayloadResultComponent {
+	return v.Result
+}
+
+// GetSuccessful returns updateComponentUpdateComponentComponentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns updateComponentUpdateComponentComponentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayload) GetMessages() []updateComponentUpdateComponentComponentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// updateComponentUpdateComponentComponentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type updateComponentUpdateComponentComponentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns updateComponentUpdateComponentComponentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns updateComponentUpdateComponentComponentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns updateComponentUpdateComponentComponentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// updateComponentUpdateComponentComponentPayloadResultComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type updateComponentUpdateComponentComponentPayloadResultComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
+
+// GetId returns updateComponentUpdateComponentComponentPayloadResultComponent.Id, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayloadResultComponent) GetId() string { return v.Id }
+
+// GetName returns updateComponentUpdateComponentComponentPayloadResultComponent.Name, and is useful for accessing the field via an interface.
+func (v *updateComponentUpdateComponentComponentPayloadResultComponent) GetName() string {
+	return v.Name
+}
+
+// updateEnvironmentResponse is returned by updateEnvironment on success.
+type updateEnvironmentResponse struct {
+	// Update an environment's mutable fields (name, description, attributes).
+	UpdateEnvironment updateEnvironmentUpdateEnvironmentEnvironmentPayload `json:"updateEnvironment"`
+}
+
+// GetUpdateEnvironment returns updateEnvironmentResponse.UpdateEnvironment, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentResponse) GetUpdateEnvironment() updateEnvironmentUpdateEnvironmentEnvironmentPayload {
+	return v.UpdateEnvironment
+}
+
+// updateEnvironmentUpdateEnvironmentEnvironmentPayload includes the requested fields of the GraphQL type EnvironmentPayload.
+type updateEnvironmentUpdateEnvironmentEnvironmentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns updateEnvironmentUpdateEnvironmentEnvironmentPayload.Result, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayload) GetResult() updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment {
+	return v.Result
+}
+
+// GetSuccessful returns updateEnvironmentUpdateEnvironmentEnvironmentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns updateEnvironmentUpdateEnvironmentEnvironmentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayload) GetMessages() []updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+}
+
+// GetId returns updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetName returns updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentUpdateEnvironmentEnvironmentPayloadResultEnvironment) GetName() string {
+	return v.Name
+}
+
+// updateInstanceAlarmResponse is returned by updateInstanceAlarm on success.
+type updateInstanceAlarmResponse struct {
+	// Update a registered alarm's mutable fields.
+	//
+	// Omit a field from the input to leave it unchanged.
+	//
+	// Requires `environment:update` on the alarm's environment.
+	UpdateInstanceAlarm updateInstanceAlarmUpdateInstanceAlarmAlarmPayload `json:"updateInstanceAlarm"`
+}
+
+// GetUpdateInstanceAlarm returns updateInstanceAlarmResponse.UpdateInstanceAlarm, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmResponse) GetUpdateInstanceAlarm() updateInstanceAlarmUpdateInstanceAlarmAlarmPayload {
+	return v.UpdateInstanceAlarm
+}
+
+// updateInstanceAlarmUpdateInstanceAlarmAlarmPayload includes the requested fields of the GraphQL type AlarmPayload.
+type updateInstanceAlarmUpdateInstanceAlarmAlarmPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Result, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetResult() updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm {
+	return v.Result
+}
+
+// GetSuccessful returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Successful, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayload.Messages, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayload) GetMessages() []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm includes the requested fields of the GraphQL type Alarm.
+// The GraphQL type's documentation follows.
+//
+// A cloud metric alarm attached to an instance.
+//
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
+//
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm struct {
+	// Unique identifier for this alarm.
+	Id string `json:"id"`
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
+	CloudResourceId string `json:"cloudResourceId"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
+	ComparisonOperator string `json:"comparisonOperator"`
+	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
+	Threshold float64 `json:"threshold"`
+	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
+	Period int `json:"period"`
+	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
+	Metric *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric `json:"metric"`
+}
+
+// GetId returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Id, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetId() string { return v.Id }
+
+// GetDisplayName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetDisplayName() string {
+	return v.DisplayName
+}
+
+// GetCloudResourceId returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetCloudResourceId() string {
+	return v.CloudResourceId
+}
+
+// GetComparisonOperator returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetComparisonOperator() string {
+	return v.ComparisonOperator
+}
+
+// GetThreshold returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Threshold, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetThreshold() float64 {
+	return v.Threshold
+}
+
+// GetPeriod returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Period, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetPeriod() int {
+	return v.Period
+}
+
+// GetMetric returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm.Metric, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarm) GetMetric() *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric {
+	return v.Metric
+}
+
+// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// The GraphQL type's documentation follows.
+//
+// The cloud metric an alarm is evaluating.
+//
+// Shape and populated fields vary by provider. AWS and Azure populate
+// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
+// are populated when the provider exposes them as structured key-value pairs.
+type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric struct {
+	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
+	Namespace string `json:"namespace"`
+	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+	Name string `json:"name"`
+	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
+	Statistic string `json:"statistic"`
+	// Cloud region this metric is scoped to, when provider-reported.
+	Region string `json:"region"`
+	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
+	Dimensions []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+}
+
+// GetNamespace returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Namespace, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetNamespace() string {
+	return v.Namespace
+}
+
+// GetName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Name, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetName() string {
+	return v.Name
+}
+
+// GetStatistic returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Statistic, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetStatistic() string {
+	return v.Statistic
+}
+
+// GetRegion returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Region, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetRegion() string {
+	return v.Region
+}
+
+// GetDimensions returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetDimensions() []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension {
+	return v.Dimensions
+}
+
+// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
+// The GraphQL type's documentation follows.
+//
+// A key-value pair identifying the specific cloud resource a metric applies to.
+//
+// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
+// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
+type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension struct {
+	// Dimension name as defined by the cloud provider.
+	Name string `json:"name"`
+	// Dimension value identifying the monitored resource.
+	Value string `json:"value"`
+}
+
+// GetName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
+	return v.Name
+}
+
+// GetValue returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
+func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
+	return v.Value
+}
+
+// updateProjectResponse is returned by updateProject on success.
+type updateProjectResponse struct {
+	// Update a project's mutable fields (name, description, attributes).
+	UpdateProject updateProjectUpdateProjectProjectPayload `json:"updateProject"`
+}
+
+// GetUpdateProject returns updateProjectResponse.UpdateProject, and is useful for accessing the field via an interface.
+func (v *updateProjectResponse) GetUpdateProject() updateProjectUpdateProjectProjectPayload {
+	return v.UpdateProject
+}
+
+// updateProjectUpdateProjectProjectPayload includes the requested fields of the GraphQL type ProjectPayload.
+type updateProjectUpdateProjectProjectPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result updateProjectUpdateProjectProjectPayloadResultProject `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []updateProjectUpdateProjectProjectPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns updateProjectUpdateProjectProjectPayload.Result, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayload) GetResult() updateProjectUpdateProjectProjectPayloadResultProject {
+	return v.Result
+}
+
+// GetSuccessful returns updateProjectUpdateProjectProjectPayload.Successful, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns updateProjectUpdateProjectProjectPayload.Messages, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayload) GetMessages() []updateProjectUpdateProjectProjectPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// updateProjectUpdateProjectProjectPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type updateProjectUpdateProjectProjectPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns updateProjectUpdateProjectProjectPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns updateProjectUpdateProjectProjectPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns updateProjectUpdateProjectProjectPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// updateProjectUpdateProjectProjectPayloadResultProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type updateProjectUpdateProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
+	Name string `json:"name"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
+	Attributes map[string]any `json:"-"`
+}
+
+// GetId returns updateProjectUpdateProjectProjectPayloadResultProject.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) GetId() string { return v.Id }
+
+// GetName returns updateProjectUpdateProjectProjectPayloadResultProject.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) GetName() string { return v.Name }
+
+// GetDescription returns updateProjectUpdateProjectProjectPayloadResultProject.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) GetDescription() string {
+	return v.Description
+}
+
+// GetAttributes returns updateProjectUpdateProjectProjectPayloadResultProject.Attributes, and is useful for accessing the field via an interface.
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectUpdateProjectProjectPayloadResultProject
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectUpdateProjectProjectPayloadResultProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal updateProjectUpdateProjectProjectPayloadResultProject.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupdateProjectUpdateProjectProjectPayloadResultProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectUpdateProjectProjectPayloadResultProject) __premarshalJSON() (*__premarshalupdateProjectUpdateProjectProjectPayloadResultProject, error) {
+	var retval __premarshalupdateProjectUpdateProjectProjectPayloadResultProject
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal updateProjectUpdateProjectProjectPayloadResultProject.Attributes: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// updateResourceResponse is returned by updateResource on success.
+type updateResourceResponse struct {
+	// Update an imported resource's name or payload.
+	//
+	// Only resources with origin `IMPORTED` can be updated. Attempting to update a
+	// `PROVISIONED` resource returns an error — those are managed by their owning
+	// instance's deployment lifecycle.
+	UpdateResource updateResourceUpdateResourceResourcePayload `json:"updateResource"`
+}
+
+// GetUpdateResource returns updateResourceResponse.UpdateResource, and is useful for accessing the field via an interface.
+func (v *updateResourceResponse) GetUpdateResource() updateResourceUpdateResourceResourcePayload {
+	return v.UpdateResource
+}
+
+// updateResourceUpdateResourceResourcePayload includes the requested fields of the GraphQL type ResourcePayload.
+type updateResourceUpdateResourceResourcePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result updateResourceUpdateResourceResourcePayloadResultResource `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []updateResourceUpdateResourceResourcePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns updateResourceUpdateResourceResourcePayload.Result, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayload) GetResult() updateResourceUpdateResourceResourcePayloadResultResource {
+	return v.Result
+}
+
+// GetSuccessful returns updateResourceUpdateResourceResourcePayload.Successful, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns updateResourceUpdateResourceResourcePayload.Messages, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayload) GetMessages() []updateResourceUpdateResourceResourcePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// updateResourceUpdateResourceResourcePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type updateResourceUpdateResourceResourcePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns updateResourceUpdateResourceResourcePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns updateResourceUpdateResourceResourcePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns updateResourceUpdateResourceResourcePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// updateResourceUpdateResourceResourcePayloadResultResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type updateResourceUpdateResourceResourcePayloadResultResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created. Determines whether it can be modified through the API.
+	Origin ResourceOrigin `json:"origin"`
+	// Key-value attributes assigned directly to this resource, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType *updateResourceUpdateResourceResourcePayloadResultResourceResourceType `json:"resourceType"`
+}
+
+// GetId returns updateResourceUpdateResourceResourcePayloadResultResource.Id, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) GetId() string { return v.Id }
+
+// GetName returns updateResourceUpdateResourceResourcePayloadResultResource.Name, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) GetName() string { return v.Name }
+
+// GetOrigin returns updateResourceUpdateResourceResourcePayloadResultResource.Origin, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) GetOrigin() ResourceOrigin {
+	return v.Origin
+}
+
+// GetAttributes returns updateResourceUpdateResourceResourcePayloadResultResource.Attributes, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+// GetResourceType returns updateResourceUpdateResourceResourcePayloadResultResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) GetResourceType() *updateResourceUpdateResourceResourcePayloadResultResourceResourceType {
+	return v.ResourceType
+}
+
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateResourceUpdateResourceResourcePayloadResultResource
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateResourceUpdateResourceResourcePayloadResultResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal updateResourceUpdateResourceResourcePayloadResultResource.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupdateResourceUpdateResourceResourcePayloadResultResource struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	ResourceType *updateResourceUpdateResourceResourcePayloadResultResourceResourceType `json:"resourceType"`
+}
+
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateResourceUpdateResourceResourcePayloadResultResource) __premarshalJSON() (*__premarshalupdateResourceUpdateResourceResourcePayloadResultResource, error) {
+	var retval __premarshalupdateResourceUpdateResourceResourcePayloadResultResource
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal updateResourceUpdateResourceResourcePayloadResultResource.Attributes: %w", err)
+		}
+	}
+	retval.ResourceType = v.ResourceType
+	return &retval, nil
+}
+
+// updateResourceUpdateResourceResourcePayloadResultResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type updateResourceUpdateResourceResourcePayloadResultResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+}
+
+// GetId returns updateResourceUpdateResourceResourcePayloadResultResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *updateResourceUpdateResourceResourcePayloadResultResourceResourceType) GetId() string {
+	return v.Id
+}
+
+// The mutation executed by addComponent.
+const addComponent_Operation = `
+mutation addComponent ($organizationId: ID!, $projectId: ID!, $ociRepoName: OciRepoName!, $input: AddComponentInput!) {
+	addComponent(organizationId: $organizationId, projectId: $projectId, ociRepoName: $ociRepoName, input: $input) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func addComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	projectId string,
+	ociRepoName string,
+	input AddComponentInput,
+) (data_ *addComponentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "addComponent",
+		Query:  addComponent_Operation,
+		Variables: &__addComponentInput{
+			OrganizationId: organizationId,
+			ProjectId:      projectId,
+			OciRepoName:    ociRepoName,
+			Input:          input,
+		},
+	}
+
+	data_ = &addComponentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by cloneProject.
+const cloneProject_Operation = `
+mutation cloneProject ($organizationId: ID!, $sourceProjectId: ID!, $input: CloneProjectInput!) {
+	cloneProject(organizationId: $organizationId, sourceProjectId: $sourceProjectId, input: $input) {
+		result {
+			id
+			name
+			description
+			attributes
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func cloneProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	sourceProjectId string,
+	input CloneProjectInput,
+) (data_ *cloneProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "cloneProject",
+		Query:  cloneProject_Operation,
+		Variables: &__cloneProjectInput{
+			OrganizationId:  organizationId,
+			SourceProjectId: sourceProjectId,
+			Input:           input,
+		},
+	}
+
+	data_ = &cloneProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by createEnvironment.
+const createEnvironment_Operation = `
+mutation createEnvironment ($organizationId: ID!, $projectId: ID!, $input: CreateEnvironmentInput!) {
+	createEnvironment(organizationId: $organizationId, projectId: $projectId, input: $input) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func createEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	projectId string,
+	input CreateEnvironmentInput,
+) (data_ *createEnvironmentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "createEnvironment",
+		Query:  createEnvironment_Operation,
+		Variables: &__createEnvironmentInput{
+			OrganizationId: organizationId,
+			ProjectId:      projectId,
+			Input:          input,
+		},
+	}
+
+	data_ = &createEnvironmentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by createInstanceAlarm.
+const createInstanceAlarm_Operation = `
+mutation createInstanceAlarm ($organizationId: ID!, $instanceId: ID!, $input: CreateInstanceAlarmInput!) {
+	createInstanceAlarm(organizationId: $organizationId, instanceId: $instanceId, input: $input) {
+		result {
+			id
+			displayName
+			cloudResourceId
+			comparisonOperator
+			threshold
+			period
+			metric {
+				namespace
+				name
+				statistic
+				region
+				dimensions {
+					name
+					value
+				}
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func createInstanceAlarm(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	instanceId string,
+	input CreateInstanceAlarmInput,
+) (data_ *createInstanceAlarmResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "createInstanceAlarm",
+		Query:  createInstanceAlarm_Operation,
+		Variables: &__createInstanceAlarmInput{
+			OrganizationId: organizationId,
+			InstanceId:     instanceId,
+			Input:          input,
+		},
+	}
+
+	data_ = &createInstanceAlarmResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by createProject.
+const createProject_Operation = `
+mutation createProject ($organizationId: ID!, $input: CreateProjectInput!) {
+	createProject(organizationId: $organizationId, input: $input) {
+		result {
+			id
+			name
+			description
+			attributes
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func createProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	input CreateProjectInput,
+) (data_ *createProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "createProject",
+		Query:  createProject_Operation,
+		Variables: &__createProjectInput{
+			OrganizationId: organizationId,
+			Input:          input,
+		},
+	}
+
+	data_ = &createProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by createResource.
+const createResource_Operation = `
+mutation createResource ($organizationId: ID!, $resourceTypeId: ID!, $input: CreateResourceInput!) {
+	createResource(organizationId: $organizationId, resourceTypeId: $resourceTypeId, input: $input) {
+		result {
+			id
+			name
+			origin
+			attributes
+			resourceType {
+				id
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func createResource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	resourceTypeId string,
+	input CreateResourceInput,
+) (data_ *createResourceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "createResource",
+		Query:  createResource_Operation,
+		Variables: &__createResourceInput{
+			OrganizationId: organizationId,
+			ResourceTypeId: resourceTypeId,
+			Input:          input,
+		},
+	}
+
+	data_ = &createResourceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by deleteEnvironment.
+const deleteEnvironment_Operation = `
+mutation deleteEnvironment ($organizationId: ID!, $id: ID!) {
+	deleteEnvironment(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func deleteEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *deleteEnvironmentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "deleteEnvironment",
+		Query:  deleteEnvironment_Operation,
+		Variables: &__deleteEnvironmentInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &deleteEnvironmentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by deleteInstanceAlarm.
+const deleteInstanceAlarm_Operation = `
+mutation deleteInstanceAlarm ($organizationId: ID!, $id: UUID!) {
+	deleteInstanceAlarm(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			displayName
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func deleteInstanceAlarm(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *deleteInstanceAlarmResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "deleteInstanceAlarm",
+		Query:  deleteInstanceAlarm_Operation,
+		Variables: &__deleteInstanceAlarmInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &deleteInstanceAlarmResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by deleteProject.
+const deleteProject_Operation = `
+mutation deleteProject ($organizationId: ID!, $id: ID!) {
+	deleteProject(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func deleteProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *deleteProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "deleteProject",
+		Query:  deleteProject_Operation,
+		Variables: &__deleteProjectInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &deleteProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by deleteResource.
+const deleteResource_Operation = `
+mutation deleteResource ($organizationId: ID!, $id: ID!) {
+	deleteResource(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func deleteResource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *deleteResourceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "deleteResource",
+		Query:  deleteResource_Operation,
+		Variables: &__deleteResourceInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &deleteResourceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by deleteResourceType.
+const deleteResourceType_Operation = `
+mutation deleteResourceType ($organizationId: ID!, $id: ID!) {
+	deleteResourceType(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func deleteResourceType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *deleteResourceTypeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "deleteResourceType",
+		Query:  deleteResourceType_Operation,
+		Variables: &__deleteResourceTypeInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &deleteResourceTypeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by exportResource.
+const exportResource_Operation = `
+mutation exportResource ($organizationId: ID!, $id: ID!, $format: String) {
+	exportResource(organizationId: $organizationId, id: $id, format: $format) {
+		result {
+			id
+			name
+			origin
+			payload
+			rendered
+			resourceType {
+				id
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+// exportResource returns the payload with `$md.sensitive` fields unmasked and
+// is recorded in the organization's audit log, so the `massdriver_resource`
+// data source only calls it when `export = true`.
+func exportResource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	format string,
+) (data_ *exportResourceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "exportResource",
+		Query:  exportResource_Operation,
+		Variables: &__exportResourceInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Format:         format,
+		},
+	}
+
+	data_ = &exportResourceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by forkEnvironment.
+const forkEnvironment_Operation = `
+mutation forkEnvironment ($organizationId: ID!, $parentId: ID!, $input: ForkEnvironmentInput!) {
+	forkEnvironment(organizationId: $organizationId, parentId: $parentId, input: $input) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func forkEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	parentId string,
+	input ForkEnvironmentInput,
+) (data_ *forkEnvironmentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "forkEnvironment",
+		Query:  forkEnvironment_Operation,
+		Variables: &__forkEnvironmentInput{
+			OrganizationId: organizationId,
+			ParentId:       parentId,
+			Input:          input,
+		},
+	}
+
+	data_ = &forkEnvironmentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getComponent.
+const getComponent_Operation = `
+query getComponent ($organizationId: ID!, $id: ID!) {
+	component(organizationId: $organizationId, id: $id) {
+		id
+		name
+		description
+		attributes
+		position {
+			x
+			y
+		}
+		ociRepo {
+			name
+		}
+		project {
+			id
+		}
+		deletable {
+			result
+			constraints {
+				message
+				type
+				id
+			}
+		}
+	}
+}
+`
+
+func getComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getComponentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getComponent",
+		Query:  getComponent_Operation,
+		Variables: &__getComponentInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getComponentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getComponentLinks.
+const getComponentLinks_Operation = `
+query getComponentLinks ($organizationId: ID!, $componentId: ID!, $cursor: Cursor) {
+	component(organizationId: $organizationId, id: $componentId) {
+		project {
+			blueprint {
+				links(filter: {fromComponentId:{eq:$componentId}}, cursor: $cursor) {
+					cursor {
+						next
+					}
+					items {
+						id
+						fromField
+						toField
+						fromComponent {
+							id
+						}
+						toComponent {
+							id
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+// There is no top-level `link` query, so links are read by walking the
+// source component's project blueprint, filtered to links leaving that
+// component. The caller matches by link ID client-side.
+func getComponentLinks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	componentId string,
+	cursor *Cursor,
+) (data_ *getComponentLinksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getComponentLinks",
+		Query:  getComponentLinks_Operation,
+		Variables: &__getComponentLinksInput{
+			OrganizationId: organizationId,
+			ComponentId:    componentId,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &getComponentLinksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getEnvironment.
+const getEnvironment_Operation = `
+query getEnvironment ($organizationId: ID!, $id: ID!) {
+	environment(organizationId: $organizationId, id: $id) {
+		id
+		name
+		description
+		attributes
+		effectiveAttributes
+		project {
+			id
+		}
+		cost {
+			lastMonth {
+				amount
+				currency
+			}
+			monthlyAverage {
+				amount
+				currency
+			}
+			lastDay {
+				amount
+				currency
+			}
+			dailyAverage {
+				amount
+				currency
+			}
+		}
+		deletable {
+			result
+			constraints {
+				message
+				type
+				id
+			}
+		}
+	}
+}
+`
+
+func getEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getEnvironmentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getEnvironment",
+		Query:  getEnvironment_Operation,
+		Variables: &__getEnvironmentInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getEnvironmentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getEnvironmentDefaults.
+const getEnvironmentDefaults_Operation = `
+query getEnvironmentDefaults ($organizationId: ID!, $environmentId: ID!, $cursor: Cursor) {
+	environment(organizationId: $organizationId, id: $environmentId) {
+		id
+		defaults(cursor: $cursor) {
+			cursor {
+				next
+			}
+			items {
+				id
+				resource {
+					id
+					name
+					resourceType {
+						id
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func getEnvironmentDefaults(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	environmentId string,
+	cursor *Cursor,
+) (data_ *getEnvironmentDefaultsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getEnvironmentDefaults",
+		Query:  getEnvironmentDefaults_Operation,
+		Variables: &__getEnvironmentDefaultsInput{
+			OrganizationId: organizationId,
+			EnvironmentId:  environmentId,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &getEnvironmentDefaultsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getInstance.
+const getInstance_Operation = `
+query getInstance ($organizationId: ID!, $id: ID!) {
+	instance(organizationId: $organizationId, id: $id) {
+		id
+		name
+		status
+		version
+		releaseStrategy
+		resolvedVersion
+		deployedVersion
+		availableUpgrade
+		params
+		attributes
+		effectiveAttributes
+		environment {
+			id
+		}
+		component {
+			id
+		}
+		properties {
+			name
+			path
+			value
+		}
+	}
+}
+`
+
+func getInstance(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getInstanceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getInstance",
+		Query:  getInstance_Operation,
+		Variables: &__getInstanceInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getInstanceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getInstanceAlarm.
+const getInstanceAlarm_Operation = `
+query getInstanceAlarm ($organizationId: ID!, $id: UUID!) {
+	instanceAlarm(organizationId: $organizationId, id: $id) {
+		id
+		displayName
+		cloudResourceId
+		comparisonOperator
+		threshold
+		period
+		metric {
+			namespace
+			name
+			statistic
+			region
+			dimensions {
+				name
+				value
+			}
+		}
+	}
+}
+`
+
+func getInstanceAlarm(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getInstanceAlarmResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getInstanceAlarm",
+		Query:  getInstanceAlarm_Operation,
+		Variables: &__getInstanceAlarmInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getInstanceAlarmResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getInstanceDependencies.
+const getInstanceDependencies_Operation = `
+query getInstanceDependencies ($organizationId: ID!, $id: ID!) {
+	instance(organizationId: $organizationId, id: $id) {
+		id
+		dependencies {
+			field
+			resource {
+				id
+				name
+			}
+			source {
+				__typename
+				... on RemoteReference {
+					id
+				}
+				... on Connection {
+					id
+					fromField
+					fromInstance {
+						id
+					}
+				}
+				... on EnvironmentDefault {
+					id
+				}
+			}
+		}
+	}
+}
+`
+
+func getInstanceDependencies(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getInstanceDependenciesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getInstanceDependencies",
+		Query:  getInstanceDependencies_Operation,
+		Variables: &__getInstanceDependenciesInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getInstanceDependenciesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getProject.
+const getProject_Operation = `
+query getProject ($organizationId: ID!, $id: ID!) {
+	project(organizationId: $organizationId, id: $id) {
+		id
+		name
+		description
+		attributes
+		deletable {
+			result
+			constraints {
+				message
+				type
+				id
+			}
+		}
+	}
+}
+`
+
+func getProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getProject",
+		Query:  getProject_Operation,
+		Variables: &__getProjectInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getResource.
+const getResource_Operation = `
+query getResource ($organizationId: ID!, $id: ID!) {
+	resource(organizationId: $organizationId, id: $id) {
+		id
+		name
+		origin
+		field
+		formats
+		payload
+		attributes
+		resourceType {
+			id
+		}
+		instance {
+			id
+		}
+	}
+}
+`
+
+func getResource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getResourceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getResource",
+		Query:  getResource_Operation,
+		Variables: &__getResourceInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getResourceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by getResourceType.
+const getResourceType_Operation = `
+query getResourceType ($organizationId: ID!, $id: ID!) {
+	resourceType(organizationId: $organizationId, id: $id) {
+		id
+		name
+		icon
+		connectionOrientation
+		schema
+		uiSchema
+		instructions {
+			label
+			content
+		}
+	}
+}
+`
+
+func getResourceType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *getResourceTypeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getResourceType",
+		Query:  getResourceType_Operation,
+		Variables: &__getResourceTypeInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &getResourceTypeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by linkComponents.
+const linkComponents_Operation = `
+mutation linkComponents ($organizationId: ID!, $input: LinkComponentsInput!) {
+	linkComponents(organizationId: $organizationId, input: $input) {
+		result {
+			id
+			fromField
+			toField
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func linkComponents(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	input LinkComponentsInput,
+) (data_ *linkComponentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "linkComponents",
+		Query:  linkComponents_Operation,
+		Variables: &__linkComponentsInput{
+			OrganizationId: organizationId,
+			Input:          input,
+		},
+	}
+
+	data_ = &linkComponentsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by listInstanceAlarms.
+const listInstanceAlarms_Operation = `
+query listInstanceAlarms ($organizationId: ID!, $filter: InstanceAlarmsFilter, $cursor: Cursor) {
+	instanceAlarms(organizationId: $organizationId, filter: $filter, cursor: $cursor) {
+		cursor {
+			next
+		}
+		items {
+			id
+			displayName
+			cloudResourceId
+			comparisonOperator
+			threshold
+			period
+			metric {
+				namespace
+				name
+				statistic
+				region
+				dimensions {
+					name
+					value
+				}
+			}
+		}
+	}
+}
+`
+
+// listInstanceAlarms is used by the package_alarm self-heal path: when a user
+// has lost the alarm's UUID from state (a pre-1.3 deploy with the dead REST
+// endpoint silently cleared it), we can recover by listing alarms on the
+// instance and matching by cloudResourceId client-side. The schema's filter
+// doesn't expose cloudResourceId directly, so we filter by instanceId and
+// walk the page items.
+//
+// Every InstanceAlarmsFilter field needs `omitempty: true, pointer: true` —
+// otherwise unset fields marshal as bare empty objects (`"projectId": {}`),
+// which the server interprets as a filter present-but-matching-nothing and
+// silently returns zero items. The instance-only filter we want has to come
+// across the wire as `{"instanceId": {"eq": "..."}}` with no sibling keys.
+func listInstanceAlarms(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	filter *InstanceAlarmsFilter,
+	cursor *Cursor,
+) (data_ *listInstanceAlarmsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "listInstanceAlarms",
+		Query:  listInstanceAlarms_Operation,
+		Variables: &__listInstanceAlarmsInput{
+			OrganizationId: organizationId,
+			Filter:         filter,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &listInstanceAlarmsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by listResources.
+const listResources_Operation = `
+query listResources ($organizationId: ID!, $filter: ResourcesFilter, $cursor: Cursor) {
+	resources(organizationId: $organizationId, filter: $filter, cursor: $cursor) {
+		cursor {
+			next
+		}
+		items {
+			id
+			name
+			origin
+			field
+			attributes
+			resourceType {
+				id
+			}
+		}
+	}
+}
+`
+
+// Used by `massdriver_resource_type` (blocking deletes) and the
+// `massdriver_resources` data source. Unset ResourcesFilter fields must be
+// omitted entirely; see the note on listInstanceAlarms.
+func listResources(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	filter *ResourcesFilter,
+	cursor *Cursor,
+) (data_ *listResourcesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "listResources",
+		Query:  listResources_Operation,
+		Variables: &__listResourcesInput{
+			OrganizationId: organizationId,
+			Filter:         filter,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &listResourcesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by publishResourceType.
+const publishResourceType_Operation = `
+mutation publishResourceType ($organizationId: ID!, $input: PublishResourceTypeInput!) {
+	publishResourceType(organizationId: $organizationId, input: $input) {
+		result {
+			id
+			name
+			schema
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+// Backs `massdriver_resource_type`. publishResourceType is an upsert keyed by
+// the schema's `$md.name`. Both mutations are marked deprecated server-side
+// in favor of OCI publishing, but are the only API for resource types today.
+func publishResourceType(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	input PublishResourceTypeInput,
+) (data_ *publishResourceTypeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "publishResourceType",
+		Query:  publishResourceType_Operation,
+		Variables: &__publishResourceTypeInput{
+			OrganizationId: organizationId,
+			Input:          input,
+		},
+	}
+
+	data_ = &publishResourceTypeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by removeComponent.
+const removeComponent_Operation = `
+mutation removeComponent ($organizationId: ID!, $id: ID!) {
+	removeComponent(organizationId: $organizationId, id: $id) {
+		result {
+			id
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func removeComponent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *removeComponentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "removeComponent",
+		Query:  removeComponent_Operation,
+		Variables: &__removeComponentInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &removeComponentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by removeEnvironmentDefault.
+const removeEnvironmentDefault_Operation = `
+mutation removeEnvironmentDefault ($organizationId: ID!, $id: UUID!) {
+	removeEnvironmentDefault(organizationId: $organizationId, id: $id) {
+		result {
+			id
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func removeEnvironmentDefault(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *removeEnvironmentDefaultResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "removeEnvironmentDefault",
+		Query:  removeEnvironmentDefault_Operation,
+		Variables: &__removeEnvironmentDefaultInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &removeEnvironmentDefaultResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by removeRemoteReference.
+const removeRemoteReference_Operation = `
+mutation removeRemoteReference ($organizationId: ID!, $instanceId: ID!, $input: RemoveRemoteReferenceInput!) {
+	removeRemoteReference(organizationId: $organizationId, instanceId: $instanceId, input: $input) {
+		result {
+			id
+			field
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func removeRemoteReference(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	instanceId string,
+	input RemoveRemoteReferenceInput,
+) (data_ *removeRemoteReferenceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "removeRemoteReference",
+		Query:  removeRemoteReference_Operation,
+		Variables: &__removeRemoteReferenceInput{
+			OrganizationId: organizationId,
+			InstanceId:     instanceId,
+			Input:          input,
+		},
+	}
+
+	data_ = &removeRemoteReferenceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by setComponentPosition.
+const setComponentPosition_Operation = `
+mutation setComponentPosition ($organizationId: ID!, $id: ID!, $input: SetComponentPositionInput!) {
+	setComponentPosition(organizationId: $organizationId, id: $id, input: $input) {
+		result {
+			id
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func setComponentPosition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	input SetComponentPositionInput,
+) (data_ *setComponentPositionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "setComponentPosition",
+		Query:  setComponentPosition_Operation,
+		Variables: &__setComponentPositionInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Input:          input,
+		},
+	}
+
+	data_ = &setComponentPositionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
 }
 
-// GetDimensions returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetric) GetDimensions() []updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension {
-	return v.Dimensions
+// The mutation executed by setEnvironmentDefault.
+const setEnvironmentDefault_Operation = `
+mutation setEnvironmentDefault ($organizationId: ID!, $environmentId: ID!, $resourceId: ID!) {
+	setEnvironmentDefault(organizationId: $organizationId, environmentId: $environmentId, resourceId: $resourceId) {
+		result {
+			id
+			resource {
+				id
+				name
+				resourceType {
+					id
+				}
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
 }
+`
 
-// updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
-// The GraphQL type's documentation follows.
-//
-// A key-value pair identifying the specific cloud resource a metric applies to.
-//
-// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
-// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
-type updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension struct {
-	// Dimension name as defined by the cloud provider.
-	Name string `json:"name"`
-	// Dimension value identifying the monitored resource.
-	Value string `json:"value"`
-}
+func setEnvironmentDefault(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	environmentId string,
+	resourceId string,
+) (data_ *setEnvironmentDefaultResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "setEnvironmentDefault",
+		Query:  setEnvironmentDefault_Operation,
+		Variables: &__setEnvironmentDefaultInput{
+			OrganizationId: organizationId,
+			EnvironmentId:  environmentId,
+			ResourceId:     resourceId,
+		},
+	}
 
-// GetName returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
-	return v.Name
-}
+	data_ = &setEnvironmentDefaultResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetValue returns updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
-func (v *updateInstanceAlarmUpdateInstanceAlarmAlarmPayloadResultAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
-	return v.Value
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
 }
 
-// The mutation executed by createInstanceAlarm.
-const createInstanceAlarm_Operation = `
-mutation createInstanceAlarm ($organizationId: ID!, $instanceId: ID!, $input: CreateInstanceAlarmInput!) {
-	createInstanceAlarm(organizationId: $organizationId, instanceId: $instanceId, input: $input) {
+// The mutation executed by setRemoteReference.
+const setRemoteReference_Operation = `
+mutation setRemoteReference ($organizationId: ID!, $instanceId: ID!, $resourceId: ID!, $input: SetRemoteReferenceInput!) {
+	setRemoteReference(organizationId: $organizationId, instanceId: $instanceId, resourceId: $resourceId, input: $input) {
 		result {
 			id
-			displayName
-			cloudResourceId
-			comparisonOperator
-			threshold
-			period
-			metric {
-				namespace
+			field
+			resource {
+				id
 				name
-				statistic
-				region
-				dimensions {
-					name
-					value
-				}
 			}
 		}
 		successful
@@ -1249,24 +11243,26 @@ mutation createInstanceAlarm ($organizationId: ID!, $instanceId: ID!, $input: Cr
 }
 `
 
-func createInstanceAlarm(
+func setRemoteReference(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	organizationId string,
 	instanceId string,
-	input CreateInstanceAlarmInput,
-) (data_ *createInstanceAlarmResponse, err_ error) {
+	resourceId string,
+	input SetRemoteReferenceInput,
+) (data_ *setRemoteReferenceResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "createInstanceAlarm",
-		Query:  createInstanceAlarm_Operation,
-		Variables: &__createInstanceAlarmInput{
+		OpName: "setRemoteReference",
+		Query:  setRemoteReference_Operation,
+		Variables: &__setRemoteReferenceInput{
 			OrganizationId: organizationId,
 			InstanceId:     instanceId,
+			ResourceId:     resourceId,
 			Input:          input,
 		},
 	}
 
-	data_ = &createInstanceAlarmResponse{}
+	data_ = &setRemoteReferenceResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1278,13 +11274,12 @@ func createInstanceAlarm(
 	return data_, err_
 }
 
-// The mutation executed by deleteInstanceAlarm.
-const deleteInstanceAlarm_Operation = `
-mutation deleteInstanceAlarm ($organizationId: ID!, $id: UUID!) {
-	deleteInstanceAlarm(organizationId: $organizationId, id: $id) {
+// The mutation executed by unlinkComponents.
+const unlinkComponents_Operation = `
+mutation unlinkComponents ($organizationId: ID!, $id: UUID!) {
+	unlinkComponents(organizationId: $organizationId, id: $id) {
 		result {
 			id
-			displayName
 		}
 		successful
 		messages {
@@ -1296,22 +11291,22 @@ mutation deleteInstanceAlarm ($organizationId: ID!, $id: UUID!) {
 }
 `
 
-func deleteInstanceAlarm(
+func unlinkComponents(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	organizationId string,
 	id string,
-) (data_ *deleteInstanceAlarmResponse, err_ error) {
+) (data_ *unlinkComponentsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "deleteInstanceAlarm",
-		Query:  deleteInstanceAlarm_Operation,
-		Variables: &__deleteInstanceAlarmInput{
+		OpName: "unlinkComponents",
+		Query:  unlinkComponents_Operation,
+		Variables: &__unlinkComponentsInput{
 			OrganizationId: organizationId,
 			Id:             id,
 		},
 	}
 
-	data_ = &deleteInstanceAlarmResponse{}
+	data_ = &unlinkComponentsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1323,46 +11318,44 @@ func deleteInstanceAlarm(
 	return data_, err_
 }
 
-// The query executed by getInstanceAlarm.
-const getInstanceAlarm_Operation = `
-query getInstanceAlarm ($organizationId: ID!, $id: UUID!) {
-	instanceAlarm(organizationId: $organizationId, id: $id) {
-		id
-		displayName
-		cloudResourceId
-		comparisonOperator
-		threshold
-		period
-		metric {
-			namespace
+// The mutation executed by updateComponent.
+const updateComponent_Operation = `
+mutation updateComponent ($organizationId: ID!, $id: ID!, $input: UpdateComponentInput!) {
+	updateComponent(organizationId: $organizationId, id: $id, input: $input) {
+		result {
+			id
 			name
-			statistic
-			region
-			dimensions {
-				name
-				value
-			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
 		}
 	}
 }
 `
 
-func getInstanceAlarm(
+// Same rules as updateProject: name and description are always sent,
+// attributes only when non-empty.
+func updateComponent(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	organizationId string,
 	id string,
-) (data_ *getInstanceAlarmResponse, err_ error) {
+	input UpdateComponentInput,
+) (data_ *updateComponentResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "getInstanceAlarm",
-		Query:  getInstanceAlarm_Operation,
-		Variables: &__getInstanceAlarmInput{
+		OpName: "updateComponent",
+		Query:  updateComponent_Operation,
+		Variables: &__updateComponentInput{
 			OrganizationId: organizationId,
 			Id:             id,
+			Input:          input,
 		},
 	}
 
-	data_ = &getInstanceAlarmResponse{}
+	data_ = &updateComponentResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1374,65 +11367,44 @@ func getInstanceAlarm(
 	return data_, err_
 }
 
-// The query executed by listInstanceAlarms.
-const listInstanceAlarms_Operation = `
-query listInstanceAlarms ($organizationId: ID!, $filter: InstanceAlarmsFilter, $cursor: Cursor) {
-	instanceAlarms(organizationId: $organizationId, filter: $filter, cursor: $cursor) {
-		cursor {
-			next
-		}
-		items {
+// The mutation executed by updateEnvironment.
+const updateEnvironment_Operation = `
+mutation updateEnvironment ($organizationId: ID!, $id: ID!, $input: UpdateEnvironmentInput!) {
+	updateEnvironment(organizationId: $organizationId, id: $id, input: $input) {
+		result {
 			id
-			displayName
-			cloudResourceId
-			comparisonOperator
-			threshold
-			period
-			metric {
-				namespace
-				name
-				statistic
-				region
-				dimensions {
-					name
-					value
-				}
-			}
+			name
+		}
+		successful
+		messages {
+			code
+			field
+			message
 		}
 	}
 }
 `
 
-// listInstanceAlarms is used by the package_alarm self-heal path: when a user
-// has lost the alarm's UUID from state (a pre-1.3 deploy with the dead REST
-// endpoint silently cleared it), we can recover by listing alarms on the
-// instance and matching by cloudResourceId client-side. The schema's filter
-// doesn't expose cloudResourceId directly, so we filter by instanceId and
-// walk the page items.
-//
-// Every InstanceAlarmsFilter field needs `omitempty: true, pointer: true` —
-// otherwise unset fields marshal as bare empty objects (`"projectId": {}`),
-// which the server interprets as a filter present-but-matching-nothing and
-// silently returns zero items. The instance-only filter we want has to come
-// across the wire as `{"instanceId": {"eq": "..."}}` with no sibling keys.
-func listInstanceAlarms(
+// Same rules as updateProject: name and description are always sent,
+// attributes only when non-empty.
+func updateEnvironment(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	organizationId string,
-	filter *InstanceAlarmsFilter,
-	cursor *Cursor,
-) (data_ *listInstanceAlarmsResponse, err_ error) {
+	id string,
+	input UpdateEnvironmentInput,
+) (data_ *updateEnvironmentResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "listInstanceAlarms",
-		Query:  listInstanceAlarms_Operation,
-		Variables: &__listInstanceAlarmsInput{
+		OpName: "updateEnvironment",
+		Query:  updateEnvironment_Operation,
+		Variables: &__updateEnvironmentInput{
 			OrganizationId: organizationId,
-			Filter:         filter,
-			Cursor:         cursor,
+			Id:             id,
+			Input:          input,
 		},
 	}
 
-	data_ = &listInstanceAlarmsResponse{}
+	data_ = &updateEnvironmentResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1504,3 +11476,110 @@ func updateInstanceAlarm(
 
 	return data_, err_
 }
+
+// The mutation executed by updateProject.
+const updateProject_Operation = `
+mutation updateProject ($organizationId: ID!, $id: ID!, $input: UpdateProjectInput!) {
+	updateProject(organizationId: $organizationId, id: $id, input: $input) {
+		result {
+			id
+			name
+			description
+			attributes
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+// Update always sends name and description so clearing a description in HCL
+// clears it server-side. Attributes must stay omitempty: the Map scalar
+// marshaler encodes an empty map as zero bytes (see scalars.MarshalJSON),
+// which is only valid JSON when the field is dropped entirely.
+func updateProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	input UpdateProjectInput,
+) (data_ *updateProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "updateProject",
+		Query:  updateProject_Operation,
+		Variables: &__updateProjectInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Input:          input,
+		},
+	}
+
+	data_ = &updateProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by updateResource.
+const updateResource_Operation = `
+mutation updateResource ($organizationId: ID!, $id: ID!, $input: UpdateResourceInput!) {
+	updateResource(organizationId: $organizationId, id: $id, input: $input) {
+		result {
+			id
+			name
+			origin
+			attributes
+			resourceType {
+				id
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+// Payload must stay omitempty for the same Map scalar reason as
+// updateProject's attributes.
+func updateResource(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	input UpdateResourceInput,
+) (data_ *updateResourceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "updateResource",
+		Query:  updateResource_Operation,
+		Variables: &__updateResourceInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Input:          input,
+		},
+	}
+
+	data_ = &updateResourceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
@@ -0,0 +1,63 @@
+// Package bundle reads the files a Massdriver bundle ships next to its IaC:
+// massdriver.yaml and schema-artifacts.json. A provider run can manage many
+// resources from the same bundle, so each file is parsed once per path and
+// modification time and the result shared. Callers must not modify what they
+// get back.
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileCache memoizes the parsed form of files, keyed by absolute path. An
+// entry is reused while the file's modification time and size are unchanged.
+type fileCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry[T]
+}
+
+type cacheEntry[T any] struct {
+	modTime time.Time
+	size    int64
+	value   *T
+}
+
+// load returns the cached value for path or reads and parses it. openErr
+// builds the error for a file that can't be read, so each file type keeps
+// its own wording. Parse failures are not cached.
+func (c *fileCache[T]) load(path string, openErr func(path string) error, parse func(path string, b []byte) (*T, error)) (*T, error) {
+	key, err := filepath.Abs(path)
+	if err != nil {
+		key = path
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, openErr(path)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok && e.modTime.Equal(info.ModTime()) && e.size == info.Size() {
+		return e.value, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, openErr(path)
+	}
+	value, err := parse(path, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.entries == nil {
+		c.entries = map[string]cacheEntry[T]{}
+	}
+	c.entries[key] = cacheEntry[T]{modTime: info.ModTime(), size: info.Size(), value: value}
+	return value, nil
+}
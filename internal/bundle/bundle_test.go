@@ -0,0 +1,67 @@
+package bundle_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"terraform-provider-massdriver/internal/bundle"
+)
+
+// A file is parsed once per modification time: rewriting it without moving
+// the mtime keeps the cached copy, and touching it forces a re-read.
+func TestLoadSpecCachesByModTime(t *testing.T) {
+	path := writeFile(t, "massdriver.yaml", "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc\n")
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := bundle.LoadSpec(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Same length, so only the mtime distinguishes the two versions.
+	if err := os.WriteFile(path, []byte("resources:\n  properties:\n    vpc:\n      $ref: aws-sub\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	second, err := bundle.LoadSpec(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second != first {
+		t.Error("an unchanged file should be served from the cache")
+	}
+
+	later := modTime.Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	third, err := bundle.LoadSpec(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref, _, _ := third.Ref("vpc"); ref != "aws-sub" {
+		t.Errorf("got %q after the file changed, want aws-sub", ref)
+	}
+}
+
+// Parse errors aren't cached, so fixing the file takes effect even if the
+// fix lands within the same mtime granularity.
+func TestLoadSchemasDoesNotCacheFailures(t *testing.T) {
+	path := writeFile(t, "schema-artifacts.json", "{")
+	if _, err := bundle.LoadSchemas(path); err == nil {
+		t.Fatal("expected a parse error")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"properties":{}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bundle.LoadSchemas(path); err != nil {
+		t.Errorf("got %v after fixing the file", err)
+	}
+}
@@ -0,0 +1,44 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// RefResolver supplies the JSON Schema for a resource type reference such as
+// `massdriver/aws-vpc`, which names a published type rather than a file.
+// Implementations must return a fresh map on every call; the caller may
+// modify it.
+type RefResolver interface {
+	ResolveTypeRef(ref string) (map[string]any, error)
+}
+
+// DirResolver is an offline RefResolver that reads `<org>/<type>.json` below
+// the named directory, e.g. `massdriver/aws-vpc` from
+// `<dir>/massdriver/aws-vpc.json`. Version suffixes are ignored.
+type DirResolver string
+
+func (d DirResolver) ResolveTypeRef(ref string) (map[string]any, error) {
+	path := filepath.Join(string(d), filepath.FromSlash(UnversionedRef(ref))+".json")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("no schema for resource type %s: unable to open %s", ref, path)
+	}
+	var typeSchema map[string]any
+	if err := json.Unmarshal(b, &typeSchema); err != nil {
+		return nil, fmt.Errorf("invalid JSON in %s: %w", path, err)
+	}
+	return typeSchema, nil
+}
+
+var typeRefPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*/[a-z0-9][a-z0-9-]*(@[^/#]+)?$`)
+
+// IsTypeRef reports whether a `$ref` names a resource type (`<org>/<type>`,
+// optionally `@<version>`) rather than a JSON pointer, URL or file. Paths
+// with a file extension or a leading `.` don't match.
+func IsTypeRef(ref string) bool {
+	return typeRefPattern.MatchString(ref)
+}
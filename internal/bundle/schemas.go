@@ -0,0 +1,215 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Schemas is a parsed schema-artifacts.json: one JSON Schema per resource
+// field under `properties`, plus whatever those schemas reference
+// (typically `definitions`).
+type Schemas struct {
+	// Document is the whole file. It is shared between callers; treat it as
+	// read-only.
+	Document map[string]any
+
+	// Path is the file the schemas were loaded from, for error messages.
+	Path string
+
+	raw []byte
+}
+
+var schemas fileCache[Schemas]
+
+// LoadSchemas reads and parses the schema-artifacts.json at path, or returns
+// the copy parsed earlier if the file hasn't changed.
+func LoadSchemas(path string) (*Schemas, error) {
+	return schemas.load(path, func(path string) error {
+		return fmt.Errorf("unable to open schema file: %s", path)
+	}, func(path string, b []byte) (*Schemas, error) {
+		s := Schemas{Path: path, raw: b}
+		if err := json.Unmarshal(b, &s.Document); err != nil {
+			return nil, fmt.Errorf("invalid JSON in %s: %w", path, err)
+		}
+		return &s, nil
+	})
+}
+
+// Field returns the JSON Schema for field, as written: references in it are
+// not resolved. Use Compile to validate against it.
+func (s *Schemas) Field(field string) (map[string]any, error) {
+	properties, _ := s.Document["properties"].(map[string]any)
+	fieldSchema, ok := properties[field].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("field %q does not exist in %s", field, s.Path)
+	}
+	return fieldSchema, nil
+}
+
+// Compile builds a validator for field's schema in the context of the whole
+// document. `#/definitions/...` pointers resolve against schema-artifacts.json,
+// relative file references against its directory, and resource type
+// references (`massdriver/aws-vpc`) through types. types may be nil, in which
+// case a type reference the field can reach is an error.
+func (s *Schemas) Compile(field string, types RefResolver) (*gojsonschema.Schema, error) {
+	if _, err := s.Field(field); err != nil {
+		return nil, err
+	}
+
+	// gojsonschema rewrites $refs in place, so give it a private copy rather
+	// than the cached Document.
+	var doc map[string]any
+	if err := json.Unmarshal(s.raw, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON in %s: %w", s.Path, err)
+	}
+
+	loader := gojsonschema.NewSchemaLoader()
+	refs := typeRefCollector{loader: loader, types: types, registered: map[string]bool{}, visited: map[string]bool{}}
+	fieldSchema := doc["properties"].(map[string]any)[field]
+	if err := refs.walk(fieldSchema, doc, "doc"); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	base := fileURL(s.Path)
+	if err := loader.AddSchema(base, gojsonschema.NewGoLoader(doc)); err != nil {
+		return nil, fmt.Errorf("%s is not a valid JSON Schema document: %w", s.Path, err)
+	}
+	compiled, err := loader.Compile(gojsonschema.NewGoLoader(map[string]any{
+		"$ref": base + "#/properties/" + escapePointer(field),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile the schema for field %q in %s: %w", field, s.Path, err)
+	}
+	return compiled, nil
+}
+
+// typeRefCollector points the resource type `$ref`s reachable from a schema
+// at schemas registered with loader, fetching each type once. Only what the
+// field can reach is visited, so a type reference elsewhere in the file
+// doesn't break fields that don't use it.
+type typeRefCollector struct {
+	loader     *gojsonschema.SchemaLoader
+	types      RefResolver
+	registered map[string]bool
+	// visited holds the `#/...` pointers already followed, per document.
+	visited map[string]bool
+}
+
+// walk visits node, which lives in root; rootName identifies root in
+// visited.
+func (c *typeRefCollector) walk(node any, root map[string]any, rootName string) error {
+	switch n := node.(type) {
+	case []any:
+		for _, v := range n {
+			if err := c.walk(v, root, rootName); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		if ref, ok := n["$ref"].(string); ok {
+			switch {
+			case IsTypeRef(ref):
+				target := typeRefURL(ref)
+				n["$ref"] = target
+				if err := c.register(ref, target); err != nil {
+					return err
+				}
+			case strings.HasPrefix(ref, "#"):
+				key := rootName + ref
+				if !c.visited[key] {
+					c.visited[key] = true
+					if err := c.walk(ResolvePointer(root, ref[1:]), root, rootName); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		for k, v := range n {
+			// const and enum hold data, not schemas.
+			if k == "const" || k == "enum" {
+				continue
+			}
+			if err := c.walk(v, root, rootName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *typeRefCollector) register(ref, target string) error {
+	if c.registered[target] {
+		return nil
+	}
+	c.registered[target] = true
+	if c.types == nil {
+		return fmt.Errorf("$ref %q names a resource type, and no resolver for resource types is configured", ref)
+	}
+	typeSchema, err := c.types.ResolveTypeRef(ref)
+	if err != nil {
+		return err
+	}
+	if err := c.walk(typeSchema, typeSchema, target); err != nil {
+		return err
+	}
+	if err := c.loader.AddSchema(target, gojsonschema.NewGoLoader(typeSchema)); err != nil {
+		return fmt.Errorf("schema for resource type %s: %w", ref, err)
+	}
+	return nil
+}
+
+// ResolvePointer looks up an RFC 6901 JSON pointer (the part of a `$ref`
+// after `#`) in doc. It returns nil if the pointer doesn't lead to an
+// object.
+func ResolvePointer(doc map[string]any, pointer string) map[string]any {
+	if pointer == "" {
+		return doc
+	}
+	if unescaped, err := url.PathUnescape(pointer); err == nil {
+		pointer = unescaped
+	}
+	unescaper := strings.NewReplacer("~1", "/", "~0", "~")
+	var node any = doc
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = unescaper.Replace(segment)
+		switch n := node.(type) {
+		case map[string]any:
+			node = n[segment]
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(n) {
+				return nil
+			}
+			node = n[index]
+		default:
+			return nil
+		}
+	}
+	target, _ := node.(map[string]any)
+	return target
+}
+
+// typeRefURL is the identifier a resolved type schema is registered under.
+// The scheme is opaque, so gojsonschema can only find it in its pool and
+// never tries to fetch it.
+func typeRefURL(ref string) string {
+	return "massdriver-type:" + UnversionedRef(ref)
+}
+
+func fileURL(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}).String()
+}
+
+func escapePointer(s string) string {
+	return url.PathEscape(strings.NewReplacer("~", "~0", "/", "~1").Replace(s))
+}
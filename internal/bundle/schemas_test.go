@@ -0,0 +1,153 @@
+package bundle_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func TestSchemasField(t *testing.T) {
+	path := writeFile(t, "schema-artifacts.json", `{"properties":{"vpc":{"type":"object"},"broken":"not a schema"}}`)
+
+	schemas, err := bundle.LoadSchemas(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := schemas.Field("vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["type"] != "object" {
+		t.Errorf("got %v", got)
+	}
+
+	for _, field := range []string{"database", "broken"} {
+		if _, err := schemas.Field(field); err == nil || err.Error() != `field "`+field+`" does not exist in `+path {
+			t.Errorf("%s: got %v", field, err)
+		}
+	}
+}
+
+func TestLoadSchemasErrors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := bundle.LoadSchemas(missing); err == nil || err.Error() != "unable to open schema file: "+missing {
+		t.Errorf("got %v", err)
+	}
+
+	if _, err := bundle.LoadSchemas(writeFile(t, "schema-artifacts.json", "{")); err == nil || !strings.Contains(err.Error(), "invalid JSON in") {
+		t.Errorf("got %v", err)
+	}
+}
+
+// staticResolver serves type schemas from memory, returning a fresh copy
+// each call as RefResolver requires.
+type staticResolver map[string]string
+
+func (r staticResolver) ResolveTypeRef(ref string) (map[string]any, error) {
+	raw, ok := r[bundle.UnversionedRef(ref)]
+	if !ok {
+		return nil, fmt.Errorf("no schema for resource type %s", ref)
+	}
+	var s map[string]any
+	return s, json.Unmarshal([]byte(raw), &s)
+}
+
+func TestSchemasCompile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "common.json"), []byte(`{"definitions":{"cidr":{"type":"string","pattern":"^[0-9./]+$"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "types", "massdriver"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "types", "massdriver", "aws-vpc.json"), []byte(`{"type":"object","required":["id"],"properties":{"id":{"type":"string"},"peer":{"$ref":"massdriver/aws-vpc"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	schemaPath := filepath.Join(dir, "schema-artifacts.json")
+	if err := os.WriteFile(schemaPath, []byte(`{
+		"definitions": {"port": {"type": "integer", "maximum": 65535}},
+		"properties": {
+			"db":      {"type": "object", "properties": {"port": {"$ref": "#/definitions/port"}}},
+			"network": {"type": "object", "properties": {"cidr": {"$ref": "common.json#/definitions/cidr"}}},
+			"vpc":     {"$ref": "massdriver/aws-vpc@1.0.0"}
+		}
+	}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := bundle.LoadSchemas(schemaPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		field     string
+		types     bundle.RefResolver
+		document  string
+		wantValid bool
+		wantErr   string
+	}{
+		{name: "definitions pointer, valid", field: "db", document: `{"port":5432}`, wantValid: true},
+		{name: "definitions pointer, invalid", field: "db", document: `{"port":70000}`},
+		{name: "sibling file, valid", field: "network", document: `{"cidr":"10.0.0.0/16"}`, wantValid: true},
+		{name: "sibling file, invalid", field: "network", document: `{"cidr":"not a cidr"}`},
+		{name: "type ref, valid", field: "vpc", types: bundle.DirResolver(filepath.Join(dir, "types")), document: `{"id":"vpc-1","peer":{"id":"vpc-2"}}`, wantValid: true},
+		{name: "type ref, invalid", field: "vpc", types: bundle.DirResolver(filepath.Join(dir, "types")), document: `{"id":"vpc-1","peer":{}}`},
+		{name: "type ref, pluggable resolver", field: "vpc", types: staticResolver{"massdriver/aws-vpc": `{"required":["arn"]}`}, document: `{"id":"vpc-1"}`},
+		{name: "type ref without a resolver", field: "vpc", wantErr: "no resolver for resource types"},
+		{name: "type ref missing from the directory", field: "vpc", types: bundle.DirResolver(t.TempDir()), wantErr: "no schema for resource type massdriver/aws-vpc@1.0.0"},
+		{name: "unknown field", field: "cache", wantErr: `field "cache" does not exist`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			compiled, err := schemas.Compile(tc.field, tc.types)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := compiled.Validate(gojsonschema.NewStringLoader(tc.document))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Valid() != tc.wantValid {
+				t.Errorf("got valid=%v, want %v (errors %v)", result.Valid(), tc.wantValid, result.Errors())
+			}
+		})
+	}
+
+	// Compiling must leave the cached document as written.
+	vpc, _ := schemas.Field("vpc")
+	if vpc["$ref"] != "massdriver/aws-vpc@1.0.0" {
+		t.Errorf("Compile modified the shared document: $ref is now %v", vpc["$ref"])
+	}
+}
+
+func TestIsTypeRef(t *testing.T) {
+	for ref, want := range map[string]bool{
+		"massdriver/aws-vpc":       true,
+		"my-org/custom-type@1.2.3": true,
+		"#/definitions/port":       false,
+		"common.json":              false,
+		"./types/aws-vpc":          false,
+		"types/aws-vpc.json":       false,
+		"https://example.com/x":    false,
+		"aws-vpc":                  false,
+	} {
+		if got := bundle.IsTypeRef(ref); got != want {
+			t.Errorf("IsTypeRef(%q) = %v, want %v", ref, got, want)
+		}
+	}
+}
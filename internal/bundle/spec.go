@@ -0,0 +1,76 @@
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Spec is the slice of massdriver.yaml the provider reads: the `$ref` of each
+// resource the bundle produces. Current bundles declare them under
+// `resources:`; older ones use `artifacts:`. Both are read, and `resources`
+// wins when a field is declared in each.
+type Spec struct {
+	Resources Section `yaml:"resources"`
+	Artifacts Section `yaml:"artifacts"`
+
+	// Path is the file the spec was loaded from, for error messages.
+	Path string `yaml:"-"`
+}
+
+// Section is one of the `resources` / `artifacts` blocks.
+type Section struct {
+	Properties map[string]map[string]any `yaml:"properties"`
+}
+
+var specs fileCache[Spec]
+
+// LoadSpec reads and parses the massdriver.yaml at path, or returns the copy
+// parsed earlier if the file hasn't changed.
+func LoadSpec(path string) (*Spec, error) {
+	return specs.load(path, func(path string) error {
+		return fmt.Errorf("unable to open specification file: %s", path)
+	}, func(path string, b []byte) (*Spec, error) {
+		spec := Spec{Path: path}
+		if err := yaml.Unmarshal(b, &spec); err != nil {
+			return nil, fmt.Errorf("invalid YAML in %s: %w", path, err)
+		}
+		return &spec, nil
+	})
+}
+
+// Ref returns the resource type field refers to, with any `@version` suffix
+// dropped: resource types are not versioned in the API. Bare and
+// fully-qualified references are returned as written. duplicate reports that
+// the field is declared under both keys, in which case the `resources` value
+// is returned.
+func (s *Spec) Ref(field string) (ref string, duplicate bool, err error) {
+	declaration, inResources := s.Resources.Properties[field]
+	legacy, inArtifacts := s.Artifacts.Properties[field]
+	switch {
+	case inResources && inArtifacts:
+		duplicate = true
+	case inArtifacts:
+		declaration = legacy
+	case !inResources:
+		return "", false, fmt.Errorf("field %q does not exist in %s", field, s.Path)
+	}
+
+	ref, ok := declaration["$ref"].(string)
+	if !ok || ref == "" {
+		return "", duplicate, fmt.Errorf("field %q in %s has no $ref", field, s.Path)
+	}
+	return UnversionedRef(ref), duplicate, nil
+}
+
+// UnversionedRef strips a version pin from a type reference, so
+// `massdriver/aws-iam-role@1.2.0` and `aws-iam-role@latest` become
+// `massdriver/aws-iam-role` and `aws-iam-role`.
+func UnversionedRef(ref string) string {
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	if i := strings.Index(name, "@"); i >= 0 {
+		return ref[:len(ref)-len(name)+i]
+	}
+	return ref
+}
@@ -0,0 +1,128 @@
+package bundle_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-massdriver/internal/bundle"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSpecRef(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          string
+		want          string
+		wantErr       string
+		wantDuplicate bool
+	}{
+		{
+			name: "resources only",
+			spec: "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc\n",
+			want: "aws-vpc",
+		},
+		{
+			name: "artifacts only",
+			spec: "artifacts:\n  properties:\n    vpc:\n      $ref: aws-vpc\n",
+			want: "aws-vpc",
+		},
+		{
+			name:          "both keys prefer resources",
+			spec:          "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc\nartifacts:\n  properties:\n    vpc:\n      $ref: aws-legacy-vpc\n",
+			want:          "aws-vpc",
+			wantDuplicate: true,
+		},
+		{
+			name: "both keys, different fields",
+			spec: "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc\nartifacts:\n  properties:\n    subnet:\n      $ref: aws-subnet\n",
+			want: "aws-vpc",
+		},
+		{
+			name: "field only under artifacts when resources is present",
+			spec: "resources:\n  properties:\n    subnet:\n      $ref: aws-subnet\nartifacts:\n  properties:\n    vpc:\n      $ref: aws-vpc\n",
+			want: "aws-vpc",
+		},
+		{
+			name: "fully-qualified",
+			spec: "resources:\n  properties:\n    vpc:\n      $ref: massdriver/aws-vpc\n",
+			want: "massdriver/aws-vpc",
+		},
+		{
+			name: "versioned bare",
+			spec: "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc@1.2.0\n",
+			want: "aws-vpc",
+		},
+		{
+			name: "versioned fully-qualified",
+			spec: "artifacts:\n  properties:\n    vpc:\n      $ref: massdriver/aws-vpc@latest\n",
+			want: "massdriver/aws-vpc",
+		},
+		{
+			name:    "neither key",
+			spec:    "name: my-bundle\n",
+			wantErr: `field "vpc" does not exist in`,
+		},
+		{
+			name:    "no $ref",
+			spec:    "resources:\n  properties:\n    vpc:\n      title: VPC\n",
+			wantErr: "has no $ref",
+		},
+		{
+			name:          "no $ref in the preferred declaration",
+			spec:          "resources:\n  properties:\n    vpc:\n      title: VPC\nartifacts:\n  properties:\n    vpc:\n      $ref: aws-vpc\n",
+			wantErr:       "has no $ref",
+			wantDuplicate: true,
+		},
+		{
+			name: "other keys alongside $ref",
+			spec: "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc\n      title: VPC\n      $md:\n        sensitive: false\n",
+			want: "aws-vpc",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := bundle.LoadSpec(writeFile(t, "massdriver.yaml", tc.spec))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, duplicate, err := spec.Ref("vpc")
+			if duplicate != tc.wantDuplicate {
+				t.Errorf("got duplicate=%v, want %v", duplicate, tc.wantDuplicate)
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoadSpecErrors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.yaml")
+	if _, err := bundle.LoadSpec(missing); err == nil || err.Error() != "unable to open specification file: "+missing {
+		t.Errorf("got %v", err)
+	}
+
+	if _, err := bundle.LoadSpec(writeFile(t, "massdriver.yaml", "resources: [\n")); err == nil || !strings.Contains(err.Error(), "invalid YAML in") {
+		t.Errorf("got %v", err)
+	}
+}
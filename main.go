@@ -1,12 +1,17 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 
 	"terraform-provider-massdriver/massdriver"
 )
 
+// version is set by goreleaser at build time.
+var version = "dev"
+
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
 
 // If you do not have terraform installed, you can remove the formatting command, but its suggested to
@@ -18,9 +23,14 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return massdriver.Provider()
-		},
-	})
+	ctx := context.Background()
+
+	server, err := massdriver.ProviderServer(ctx, version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/massdriver-cloud/massdriver", server); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,87 @@
+package massdriver
+
+import (
+	"fmt"
+
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// bundleResourceRef returns the `$ref` the bundle's massdriver.yaml declares
+// for field (not org-prefixed), with a warning if the field is declared under
+// both `resources` and `artifacts`.
+func bundleResourceRef(field, specPath string) (string, diag.Diagnostics) {
+	spec, err := bundle.LoadSpec(specPath)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+
+	ref, duplicate, err := spec.Ref(field)
+	var diags diag.Diagnostics
+	if duplicate {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("field %q is declared under both `resources` and `artifacts`", field),
+			Detail:   fmt.Sprintf("%s declares %q in both places; the `resources` declaration is used. Remove it from `artifacts` to silence this warning.", specPath, field),
+		})
+	}
+	if err != nil {
+		return "", append(diags, diag.FromErr(err)...)
+	}
+	return ref, diags
+}
+
+// validateBundleField validates document against the JSON Schema that the
+// bundle's schema-artifacts.json declares for field, resolving references
+// within the file, to files next to it, and to resource types via types.
+func validateBundleField(summary, field, document, schemaPath string, types bundle.RefResolver, attr cty.Path) diag.Diagnostics {
+	schemas, err := bundle.LoadSchemas(schemaPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fieldSchema, err := schemas.Field(field)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	compiled, err := schemas.Compile(field, types)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return validateWithSchema(summary, compiled, bundleWalker(schemas, types), fieldSchema, document, attr)
+}
+
+// maskedBundleDigest is the canonical JSON digest of payload with the values
+// the bundle's schema-artifacts.json marks `$md.sensitive` for field masked,
+// as the API masks them in the payloads it returns.
+func maskedBundleDigest(field, schemaPath string, types bundle.RefResolver, payload map[string]any) (string, error) {
+	schemas, err := bundle.LoadSchemas(schemaPath)
+	if err != nil {
+		return "", err
+	}
+	fieldSchema, err := schemas.Field(field)
+	if err != nil {
+		return "", err
+	}
+	return canonicalJSONHash(maskSensitive(bundleWalker(schemas, types), fieldSchema, payload))
+}
+
+// bundleWalker walks schemas, resolving resource type references through
+// types (each at most once).
+func bundleWalker(schemas *bundle.Schemas, types bundle.RefResolver) schemaWalker {
+	walker := schemaWalker{root: schemas.Document}
+	if types != nil {
+		resolved := map[string]map[string]any{}
+		walker.types = func(ref string) map[string]any {
+			if typeSchema, ok := resolved[ref]; ok {
+				return typeSchema
+			}
+			typeSchema, _ := types.ResolveTypeRef(ref)
+			resolved[ref] = typeSchema
+			return typeSchema
+		}
+	}
+	return walker
+}
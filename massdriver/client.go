@@ -1,13 +1,36 @@
 package massdriver
 
 import (
+	"context"
+	"strings"
+	"sync"
+
+	"terraform-provider-massdriver/internal/api"
+	"terraform-provider-massdriver/internal/bundle"
+
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/services/artifacts"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/services/resources"
 )
 
+// ProviderClient is the meta value handed to every resource. Terraform calls
+// ConfigureContextFunc once per `provider "massdriver"` block (including each
+// `alias`), so every configured instance owns its own ProviderClient and SDK
+// client — nothing is shared between them.
 type ProviderClient struct {
 	Client *client.Client
+
+	// resourceTypeSchemas caches published resource type schemas by type ID
+	// for the life of the provider process, so a plan that validates many
+	// payloads of one type fetches its schema once. It is per instance
+	// because two aliased providers may point at different organizations.
+	resourceTypeSchemas schemaCache
+
+	// typeRefs resolves resource type `$ref`s (`massdriver/aws-vpc`) inside
+	// a bundle's schema-artifacts.json. nil unless the provider block sets
+	// `resource_type_schema_dir`.
+	typeRefs bundle.RefResolver
 }
 
 func NewProviderClient() (*ProviderClient, error) {
@@ -20,6 +43,20 @@ func NewProviderClient() (*ProviderClient, error) {
 	}, nil
 }
 
+// NewProviderClientWithConfig builds a client from an explicit config instead
+// of the SDK's environment/profile lookup. Used when the provider block
+// supplies its own credentials. The config is copied first so two provider
+// instances can never end up pointing at the same credentials.
+func NewProviderClientWithConfig(cfg *config.Config) (*ProviderClient, error) {
+	client, err := client.NewWithConfig(cloneConfig(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderClient{
+		Client: client,
+	}, nil
+}
+
 func (p *ProviderClient) ArtifactService() *artifacts.Service {
 	return artifacts.NewService(p.Client)
 }
@@ -27,3 +64,58 @@ func (p *ProviderClient) ArtifactService() *artifacts.Service {
 func (p *ProviderClient) ResourceService() *resources.Service {
 	return resources.NewService(p.Client)
 }
+
+// ResourceTypeSchema returns the JSON Schema published for a resource type,
+// fetching it on first use. Failed lookups are not cached.
+func (p *ProviderClient) ResourceTypeSchema(ctx context.Context, resourceTypeID string) (map[string]any, error) {
+	if cached, ok := p.resourceTypeSchemas.get(resourceTypeID); ok {
+		return cached, nil
+	}
+	resourceType, err := api.GetResourceType(ctx, p.Client, resourceTypeID)
+	if err != nil {
+		return nil, err
+	}
+	p.resourceTypeSchemas.put(resourceTypeID, resourceType.Schema)
+	return resourceType.Schema, nil
+}
+
+// schemaCache is a mutex-guarded map; the zero value is ready to use.
+type schemaCache struct {
+	mu      sync.Mutex
+	schemas map[string]map[string]any
+}
+
+func (c *schemaCache) get(key string) (map[string]any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.schemas[key]
+	return s, ok
+}
+
+func (c *schemaCache) put(key string, s map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.schemas == nil {
+		c.schemas = map[string]map[string]any{}
+	}
+	c.schemas[key] = s
+}
+
+// cloneConfig deep-copies an SDK config. Credentials are held by pointer, so a
+// shallow copy would leave aliased provider instances sharing (and able to
+// mutate) a single credentials struct.
+func cloneConfig(cfg *config.Config) *config.Config {
+	out := *cfg
+	if cfg.Credentials != nil {
+		creds := *cfg.Credentials
+		out.Credentials = &creds
+	}
+	return &out
+}
+
+// isNotFound reports whether an API error means the record is gone. Both the
+// REST services and the GraphQL wrappers in internal/api surface this as a
+// "not found" message rather than a typed error.
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
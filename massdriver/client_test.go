@@ -0,0 +1,20 @@
+package massdriver
+
+import (
+	"testing"
+)
+
+func TestProviderClientResourceTypeSchemaDoesNotCacheFailures(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getResourceType": {"data": map[string]any{"resourceType": nil}},
+	})
+
+	for range 2 {
+		if _, err := pc.ResourceTypeSchema(t.Context(), "aws-vpc"); err == nil {
+			t.Fatal("expected a not found error")
+		}
+	}
+	if len(rec.Requests) != 2 {
+		t.Errorf("a failed lookup should be retried, got %d requests", len(rec.Requests))
+	}
+}
@@ -0,0 +1,169 @@
+package massdriver
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceInstance() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads a Massdriver instance: its lifecycle status, bundle version resolution, deployed params and the properties its resources publish. Useful for a bundle that needs facts about a sibling instance.",
+
+		ReadContext: dataSourceInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the instance (e.g. `ecomm-prod-db`). Defaults to the instance being deployed, from `MASSDRIVER_INSTANCE_ID` or `MASSDRIVER_PACKAGE_NAME`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Display name of the instance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Lifecycle state: `INITIALIZED`, `PROVISIONED`, `DECOMMISSIONED` or `FAILED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"environment_id": {
+				Description: "ID of the environment the instance is deployed in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"component_id": {
+				Description: "ID of the blueprint component the instance was deployed from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Version constraint for the bundle (e.g. `~1.0` or `latest`).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"release_strategy": {
+				Description: "`STABLE`, or `DEVELOPMENT` when pre-release builds are eligible.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resolved_version": {
+				Description: "Bundle version the next deployment will use.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"deployed_version": {
+				Description: "Bundle version last deployed successfully. Empty if the instance has never been deployed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"available_upgrade": {
+				Description: "Newest bundle version that satisfies `version`. Empty when the instance is already up to date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"params": {
+				Description: "JSON-encoded params from the most recent deployment. `{}` if the instance has never been deployed. Use `jsondecode()` to read individual values. Marked sensitive, since params may hold secrets such as passwords.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"effective_attributes": {
+				Description: "Attributes the instance inherits from its project, environment and component merged with its own, plus the `md-*` system attributes.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"properties": {
+				Description: "Scalar values published by the instance's resources, one entry per leaf. Values of fields marked sensitive in the resource type are returned as `[SENSITIVE]`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Display label, e.g. `Database: Hostname`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path": {
+							Description: "jq-style path to the value, e.g. `.database.port`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The value as a string. Empty when the underlying value is null.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	id := d.Get("id").(string)
+	if id == "" {
+		fromEnv, _ := instanceIDFromEnv()
+		if fromEnv == nil {
+			return diag.Errorf("id must be set in config, or MASSDRIVER_INSTANCE_ID / MASSDRIVER_PACKAGE_NAME must be set in the environment")
+		}
+		id = fromEnv.(string)
+	}
+
+	instance, err := api.GetInstance(ctx, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := instance.Params
+	if params == nil {
+		params = map[string]any{}
+	}
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instance.ID)
+	d.Set("name", instance.Name)
+	d.Set("status", instance.Status)
+	d.Set("environment_id", instance.Environment.ID)
+	d.Set("component_id", instance.Component.ID)
+	d.Set("version", instance.Version)
+	d.Set("release_strategy", instance.ReleaseStrategy)
+	d.Set("resolved_version", instance.ResolvedVersion)
+	d.Set("deployed_version", instance.DeployedVersion)
+	d.Set("available_upgrade", instance.AvailableUpgrade)
+	d.Set("params", string(paramsJSON))
+	if err := d.Set("effective_attributes", instance.EffectiveAttributes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("properties", flattenInstanceProperties(instance.Properties)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func flattenInstanceProperties(properties []api.InstanceProperty) []any {
+	out := make([]any, 0, len(properties))
+	for _, p := range properties {
+		out = append(out, map[string]any{
+			"name":  p.Name,
+			"path":  p.Path,
+			"value": p.Value,
+		})
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func instanceResponse(params any) map[string]map[string]any {
+	return map[string]map[string]any{
+		"getInstance": {
+			"data": map[string]any{
+				"instance": map[string]any{
+					"id":                  "ecomm-prod-db",
+					"name":                "db",
+					"status":              "PROVISIONED",
+					"version":             "~1.2",
+					"releaseStrategy":     "STABLE",
+					"resolvedVersion":     "1.2.3",
+					"deployedVersion":     "1.2.1",
+					"availableUpgrade":    "1.2.3",
+					"params":              params,
+					"effectiveAttributes": map[string]any{"md-project": "ecomm", "team": "data"},
+					"environment":         map[string]any{"id": "ecomm-prod"},
+					"component":           map[string]any{"id": "ecomm-db"},
+					"properties": []map[string]any{
+						{"name": "Database: Hostname", "path": ".database.hostname", "value": "db.internal"},
+						{"name": "Database: Password", "path": ".database.password", "value": "[SENSITIVE]"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDataSourceInstanceRead(t *testing.T) {
+	pc, rec := newMockProvider(instanceResponse(map[string]any{"instance_class": "db.t3.medium"}))
+
+	rd := schema.TestResourceDataRaw(t, dataSourceInstance().Schema, map[string]any{
+		"id": "ecomm-prod-db",
+	})
+
+	if diags := dataSourceInstanceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("getInstance")); vars["id"] != "ecomm-prod-db" {
+		t.Errorf("got id %v", vars["id"])
+	}
+	if rd.Id() != "ecomm-prod-db" || rd.Get("status") != "PROVISIONED" || rd.Get("environment_id") != "ecomm-prod" {
+		t.Errorf("got id=%q status=%v environment_id=%v", rd.Id(), rd.Get("status"), rd.Get("environment_id"))
+	}
+	if rd.Get("deployed_version") != "1.2.1" || rd.Get("available_upgrade") != "1.2.3" {
+		t.Errorf("got deployed_version=%v available_upgrade=%v", rd.Get("deployed_version"), rd.Get("available_upgrade"))
+	}
+	if rd.Get("params") != `{"instance_class":"db.t3.medium"}` {
+		t.Errorf("got params %v", rd.Get("params"))
+	}
+	if rd.Get("effective_attributes.team") != "data" {
+		t.Errorf("got effective_attributes %v", rd.Get("effective_attributes"))
+	}
+	if rd.Get("properties.#") != 2 || rd.Get("properties.1.value") != "[SENSITIVE]" {
+		t.Errorf("got properties %v", rd.Get("properties"))
+	}
+}
+
+func TestDataSourceInstanceReadDefaultsFromEnvironment(t *testing.T) {
+	t.Setenv("MASSDRIVER_INSTANCE_ID", "")
+	t.Setenv("MASSDRIVER_PACKAGE_NAME", "ecomm-prod-db-rbpt")
+	pc, rec := newMockProvider(instanceResponse(nil))
+
+	rd := schema.TestResourceDataRaw(t, dataSourceInstance().Schema, map[string]any{})
+
+	if diags := dataSourceInstanceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("getInstance")); vars["id"] != "ecomm-prod-db" {
+		t.Errorf("got id %v, want the deployment suffix stripped", vars["id"])
+	}
+	if rd.Get("params") != "{}" {
+		t.Errorf("never-deployed params should read as {}, got %v", rd.Get("params"))
+	}
+}
+
+func TestDataSourceInstanceReadRequiresID(t *testing.T) {
+	t.Setenv("MASSDRIVER_INSTANCE_ID", "")
+	t.Setenv("MASSDRIVER_PACKAGE_NAME", "")
+	pc, _ := newMockProvider(map[string]map[string]any{})
+
+	rd := schema.TestResourceDataRaw(t, dataSourceInstance().Schema, map[string]any{})
+
+	diags := dataSourceInstanceRead(t.Context(), rd, pc)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "MASSDRIVER_INSTANCE_ID") {
+		t.Fatalf("expected a missing id error, got %v", diags)
+	}
+}
+
+func TestDataSourceInstanceSchema(t *testing.T) {
+	ds := dataSourceInstance()
+	if err := ds.InternalValidate(nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if !ds.Schema["params"].Sensitive {
+		t.Error("params should be sensitive: deployment params may hold secrets")
+	}
+}
@@ -0,0 +1,139 @@
+package massdriver
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads a Massdriver resource (provisioned or imported), e.g. another instance's database credentials. By default the payload is returned with fields marked `$md.sensitive` masked as `[SENSITIVE]`. Set `export = true` to fetch the unmasked payload through the `exportResource` mutation; **every export is recorded in the organization's audit log**, on each plan and refresh that reads this data source.",
+
+		ReadContext: dataSourceResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"export": {
+				Description: "Fetch the unmasked payload and `rendered` output via `exportResource`. Each read is audit-logged. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"format": {
+				Description: "Format for `rendered` when `export = true`, one of the resource's `formats`. Defaults to `json`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "Display name of the resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"origin": {
+				Description: "`PROVISIONED` (produced by a deployment) or `IMPORTED`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resource_type": {
+				Description: "ID of the resource's type (e.g. `aws-iam-role`).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"field": {
+				Description: "Bundle output field that produced the resource. Empty for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"instance_id": {
+				Description: "ID of the instance that produced the resource. Empty for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"formats": {
+				Description: "Formats the resource can be exported in. Always includes `json`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"payload": {
+				Description: "JSON-encoded payload. Sensitive fields are masked as `[SENSITIVE]` unless `export = true`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"rendered": {
+				Description: "The unmasked payload rendered in `format`. Empty unless `export = true`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	id := d.Get("id").(string)
+	export := d.Get("export").(bool)
+	format := d.Get("format").(string)
+	if format != "" && !export {
+		return diag.Errorf("format is only used when export = true")
+	}
+
+	resource, err := api.GetResource(ctx, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Checked here rather than left to the server so a typo is reported
+	// without writing an audit log entry for a failed export.
+	if format != "" && !slices.Contains(resource.Formats, format) {
+		return diag.Errorf("resource %s cannot be exported as %q; supported formats: %s", id, format, strings.Join(resource.Formats, ", "))
+	}
+
+	payload := resource.Payload
+	rendered := ""
+	if export {
+		exported, err := api.ExportResource(ctx, client, id, format)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		payload = exported.Payload
+		rendered = exported.Rendered
+	}
+
+	if payload == nil {
+		payload = map[string]any{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+	d.Set("name", resource.Name)
+	d.Set("origin", resource.Origin)
+	d.Set("resource_type", resource.ResourceType.ID)
+	d.Set("field", resource.Field)
+	d.Set("instance_id", resource.Instance.ID)
+	if err := d.Set("formats", resource.Formats); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("payload", string(payloadJSON))
+	d.Set("rendered", rendered)
+	return nil
+}
@@ -0,0 +1,123 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func resourceQueryResponses() map[string]map[string]any {
+	return map[string]map[string]any{
+		"getResource": {
+			"data": map[string]any{
+				"resource": map[string]any{
+					"id":           "r-1",
+					"name":         "Primary DB",
+					"origin":       "PROVISIONED",
+					"field":        "database",
+					"formats":      []string{"json", "env"},
+					"payload":      map[string]any{"data": map[string]any{"hostname": "db.internal", "password": "[SENSITIVE]"}},
+					"resourceType": map[string]any{"id": "postgresql-authentication"},
+					"instance":     map[string]any{"id": "ecomm-prod-db"},
+				},
+			},
+		},
+		"exportResource": {
+			"data": map[string]any{
+				"exportResource": map[string]any{
+					"successful": true,
+					"result": map[string]any{
+						"id":       "r-1",
+						"name":     "Primary DB",
+						"payload":  map[string]any{"data": map[string]any{"hostname": "db.internal", "password": "hunter2"}},
+						"rendered": "PGHOST=db.internal\nPGPASSWORD=hunter2\n",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDataSourceResourceReadMasked(t *testing.T) {
+	pc, rec := newMockProvider(resourceQueryResponses())
+
+	rd := schema.TestResourceDataRaw(t, dataSourceResource().Schema, map[string]any{"id": "r-1"})
+
+	if diags := dataSourceResourceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rec.FindRequest("exportResource") != nil {
+		t.Error("exportResource must only be called when export = true")
+	}
+	if rd.Get("field") != "database" || rd.Get("instance_id") != "ecomm-prod-db" || rd.Get("resource_type") != "postgresql-authentication" {
+		t.Errorf("got field=%v instance_id=%v resource_type=%v", rd.Get("field"), rd.Get("instance_id"), rd.Get("resource_type"))
+	}
+	if got := rd.Get("payload").(string); !strings.Contains(got, "[SENSITIVE]") {
+		t.Errorf("got payload %s, want the masked payload", got)
+	}
+	if rd.Get("rendered") != "" {
+		t.Errorf("rendered should be empty without export, got %v", rd.Get("rendered"))
+	}
+}
+
+func TestDataSourceResourceReadExport(t *testing.T) {
+	pc, rec := newMockProvider(resourceQueryResponses())
+
+	rd := schema.TestResourceDataRaw(t, dataSourceResource().Schema, map[string]any{
+		"id":     "r-1",
+		"export": true,
+		"format": "env",
+	})
+
+	if diags := dataSourceResourceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("exportResource")); vars["format"] != "env" || vars["id"] != "r-1" {
+		t.Errorf("got variables %v", vars)
+	}
+	if got := rd.Get("payload").(string); !strings.Contains(got, "hunter2") {
+		t.Errorf("got payload %s, want the unmasked payload", got)
+	}
+	if rd.Get("rendered") != "PGHOST=db.internal\nPGPASSWORD=hunter2\n" {
+		t.Errorf("got rendered %q", rd.Get("rendered"))
+	}
+}
+
+func TestDataSourceResourceReadRejectsFormat(t *testing.T) {
+	tests := map[string]struct {
+		config  map[string]any
+		wantErr string
+	}{
+		"format without export": {
+			config:  map[string]any{"id": "r-1", "format": "env"},
+			wantErr: "export = true",
+		},
+		"unsupported format": {
+			config:  map[string]any{"id": "r-1", "export": true, "format": "yaml"},
+			wantErr: "supported formats: json, env",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc, rec := newMockProvider(resourceQueryResponses())
+
+			rd := schema.TestResourceDataRaw(t, dataSourceResource().Schema, tc.config)
+
+			diags := dataSourceResourceRead(t.Context(), rd, pc)
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.wantErr) {
+				t.Fatalf("got %v, want error containing %q", diags, tc.wantErr)
+			}
+			if rec.FindRequest("exportResource") != nil {
+				t.Error("a rejected format must not trigger an export")
+			}
+		})
+	}
+}
+
+func TestDataSourceResourceSchema(t *testing.T) {
+	if err := dataSourceResource().InternalValidate(nil, false); err != nil {
+		t.Fatal(err)
+	}
+}
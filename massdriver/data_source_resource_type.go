@@ -0,0 +1,112 @@
+package massdriver
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceResourceType() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads a published resource type. `schema` is the authoritative copy of the type's JSON Schema, so comparing it with a bundle's local `schema-artifacts.json` shows when the local copy has drifted.",
+
+		ReadContext: dataSourceResourceTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the resource type (e.g. `aws-iam-role`).",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "Display name of the resource type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"icon": {
+				Description: "URL of the resource type's icon. Empty if it has none.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"connection_orientation": {
+				Description: "How instances receive a dependency of this type: `LINK` (drawn on the canvas) or `ENVIRONMENT_DEFAULT`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"schema": {
+				Description: "The JSON-encoded JSON Schema, including the `$md` extensions. Use `jsondecode()` to inspect it.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ui_schema": {
+				Description: "JSON-encoded UI hints for the import form, following react-jsonschema-form's `uiSchema` conventions. `{}` if the type provides none.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"instructions": {
+				Description: "Import instructions, one entry per workflow (CLI, console, ...).",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Description: "Heading for the workflow, e.g. `AWS CLI`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"content": {
+							Description: "Markdown body of the instructions.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceTypeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	rt, err := api.GetResourceType(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schemaJSON, err := json.Marshal(rt.Schema)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uiSchema := rt.UISchema
+	if uiSchema == nil {
+		uiSchema = map[string]any{}
+	}
+	uiSchemaJSON, err := json.Marshal(uiSchema)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instructions := make([]any, 0, len(rt.Instructions))
+	for _, i := range rt.Instructions {
+		instructions = append(instructions, map[string]any{
+			"label":   i.Label,
+			"content": i.Content,
+		})
+	}
+
+	d.SetId(rt.ID)
+	d.Set("name", rt.Name)
+	d.Set("icon", rt.Icon)
+	d.Set("connection_orientation", rt.ConnectionOrientation)
+	d.Set("schema", string(schemaJSON))
+	d.Set("ui_schema", string(uiSchemaJSON))
+	if err := d.Set("instructions", instructions); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
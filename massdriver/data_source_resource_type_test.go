@@ -0,0 +1,72 @@
+package massdriver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceResourceTypeRead(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getResourceType": {
+			"data": map[string]any{
+				"resourceType": map[string]any{
+					"id":                    "aws-ec2-vpc",
+					"name":                  "AWS VPC",
+					"icon":                  "https://example.com/vpc.svg",
+					"connectionOrientation": "ENVIRONMENT_DEFAULT",
+					"schema": map[string]any{
+						"$md":        map[string]any{"name": "aws-ec2-vpc"},
+						"properties": map[string]any{"data": map[string]any{"type": "object"}},
+					},
+					"uiSchema": map[string]any{"ui:order": []string{"data"}},
+					"instructions": []map[string]any{
+						{"label": "AWS CLI", "content": "aws ec2 describe-vpcs"},
+					},
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, dataSourceResourceType().Schema, map[string]any{"id": "aws-ec2-vpc"})
+
+	if diags := dataSourceResourceTypeRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "aws-ec2-vpc" || rd.Get("connection_orientation") != "ENVIRONMENT_DEFAULT" || rd.Get("icon") != "https://example.com/vpc.svg" {
+		t.Errorf("got id=%q connection_orientation=%v icon=%v", rd.Id(), rd.Get("connection_orientation"), rd.Get("icon"))
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(rd.Get("schema").(string)), &got); err != nil {
+		t.Fatalf("schema is not JSON: %v", err)
+	}
+	if got["$md"].(map[string]any)["name"] != "aws-ec2-vpc" {
+		t.Errorf("got schema %v", got)
+	}
+	if rd.Get("ui_schema") != `{"ui:order":["data"]}` {
+		t.Errorf("got ui_schema %v", rd.Get("ui_schema"))
+	}
+	if rd.Get("instructions.#") != 1 || rd.Get("instructions.0.label") != "AWS CLI" {
+		t.Errorf("got instructions %v", rd.Get("instructions"))
+	}
+}
+
+func TestDataSourceResourceTypeReadNotFound(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getResourceType": {"data": map[string]any{"resourceType": nil}},
+	})
+
+	rd := schema.TestResourceDataRaw(t, dataSourceResourceType().Schema, map[string]any{"id": "gone"})
+
+	if diags := dataSourceResourceTypeRead(t.Context(), rd, pc); !diags.HasError() {
+		t.Fatal("a missing resource type should fail the read")
+	}
+}
+
+func TestDataSourceResourceTypeSchema(t *testing.T) {
+	if err := dataSourceResourceType().InternalValidate(nil, false); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+package massdriver
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Massdriver resources matching every filter that is set, walking all result pages. Payloads are not included; read an individual resource with the `massdriver_resource` data source.",
+
+		ReadContext: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"origin": {
+				Description:  "Only return resources with this origin: `IMPORTED` or `PROVISIONED`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IMPORTED", "PROVISIONED"}, false),
+			},
+			"resource_type": {
+				Description: "Only return resources of this type (e.g. `aws-ec2-vpc`).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"environment_id": {
+				Description: "Only return resources provisioned into this environment. Imported resources have no environment, so setting this excludes them.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"search": {
+				Description: "Full-text search on the resource name. Prefix matches are included for terms longer than 3 characters.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"resources": {
+				Description: "The matching resources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Display name of the resource.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"origin": {
+							Description: "`IMPORTED` or `PROVISIONED`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_type": {
+							Description: "ID of the resource's type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"field": {
+							Description: "Bundle output field that produced the resource. Empty for imported resources.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"attributes": {
+							Description: "Key-value attributes assigned to the resource.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	origin := d.Get("origin").(string)
+	resourceType := d.Get("resource_type").(string)
+	environmentID := d.Get("environment_id").(string)
+	search := d.Get("search").(string)
+
+	filter := api.ResourcesFilter{Search: search}
+	if origin != "" {
+		filter.Origin = &api.ResourceOriginFilter{Eq: api.ResourceOrigin(origin)}
+	}
+	if resourceType != "" {
+		filter.ResourceType = &api.StringFilter{Eq: resourceType}
+	}
+	if environmentID != "" {
+		filter.EnvironmentId = &api.IdFilter{Eq: environmentID}
+	}
+
+	resources, err := api.ListResources(ctx, client, &filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]any, 0, len(resources))
+	for _, r := range resources {
+		items = append(items, map[string]any{
+			"id":            r.ID,
+			"name":          r.Name,
+			"origin":        r.Origin,
+			"resource_type": r.ResourceType.ID,
+			"field":         r.Field,
+			"attributes":    r.Attributes,
+		})
+	}
+	if err := d.Set("resources", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The result has no identity of its own; key it on the filters so the
+	// same query keeps the same ID across refreshes.
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join([]string{origin, resourceType, environmentID, search}, "\x00"))))
+	return nil
+}
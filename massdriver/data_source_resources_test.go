@@ -0,0 +1,92 @@
+package massdriver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func listResourcesResponse(items ...map[string]any) map[string]map[string]any {
+	return map[string]map[string]any{
+		"listResources": {
+			"data": map[string]any{
+				"resources": map[string]any{
+					"cursor": map[string]any{"next": nil},
+					"items":  items,
+				},
+			},
+		},
+	}
+}
+
+func TestDataSourceResourcesRead(t *testing.T) {
+	pc, rec := newMockProvider(listResourcesResponse(
+		map[string]any{
+			"id":           "r-1",
+			"name":         "shared-vpc",
+			"origin":       "IMPORTED",
+			"attributes":   map[string]any{"team": "network"},
+			"resourceType": map[string]any{"id": "aws-ec2-vpc"},
+		},
+		map[string]any{
+			"id":           "r-2",
+			"name":         "legacy-vpc",
+			"origin":       "IMPORTED",
+			"resourceType": map[string]any{"id": "aws-ec2-vpc"},
+		},
+	))
+
+	rd := schema.TestResourceDataRaw(t, dataSourceResources().Schema, map[string]any{
+		"origin":        "IMPORTED",
+		"resource_type": "aws-ec2-vpc",
+		"search":        "vpc",
+	})
+
+	if diags := dataSourceResourcesRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	filter := gqlmock.Variables(rec.FindRequest("listResources"))["filter"].(map[string]any)
+	if filter["origin"].(map[string]any)["eq"] != "IMPORTED" || filter["resourceType"].(map[string]any)["eq"] != "aws-ec2-vpc" || filter["search"] != "vpc" {
+		t.Errorf("got filter %v", filter)
+	}
+	if _, ok := filter["environmentId"]; ok {
+		t.Errorf("unset filters must be omitted, got %v", filter)
+	}
+
+	if rd.Get("resources.#") != 2 {
+		t.Fatalf("got %v resources, want 2", rd.Get("resources.#"))
+	}
+	if rd.Get("resources.0.id") != "r-1" || rd.Get("resources.0.resource_type") != "aws-ec2-vpc" || rd.Get("resources.0.attributes.team") != "network" {
+		t.Errorf("got resources %v", rd.Get("resources"))
+	}
+	if rd.Id() == "" {
+		t.Error("the data source must set an ID")
+	}
+}
+
+func TestDataSourceResourcesIDFollowsFilters(t *testing.T) {
+	read := func(config map[string]any) string {
+		pc, _ := newMockProvider(listResourcesResponse())
+		rd := schema.TestResourceDataRaw(t, dataSourceResources().Schema, config)
+		if diags := dataSourceResourcesRead(t.Context(), rd, pc); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return rd.Id()
+	}
+
+	byEnv := read(map[string]any{"environment_id": "ecomm-prod"})
+	if byEnv != read(map[string]any{"environment_id": "ecomm-prod"}) {
+		t.Error("the same filters should produce the same ID")
+	}
+	if byEnv == read(map[string]any{"environment_id": "ecomm-staging"}) {
+		t.Error("different filters should produce different IDs")
+	}
+}
+
+func TestDataSourceResourcesSchema(t *testing.T) {
+	if err := dataSourceResources().InternalValidate(nil, false); err != nil {
+		t.Fatal(err)
+	}
+}
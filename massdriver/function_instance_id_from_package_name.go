@@ -0,0 +1,49 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type instanceIDFromPackageNameFunction struct{}
+
+var _ function.Function = instanceIDFromPackageNameFunction{}
+
+func newInstanceIDFromPackageNameFunction() function.Function {
+	return instanceIDFromPackageNameFunction{}
+}
+
+func (instanceIDFromPackageNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "instance_id_from_package_name"
+}
+
+func (instanceIDFromPackageNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Strips the deployment suffix from a package name",
+		MarkdownDescription: "Returns the instance ID for a package name by dropping its last hyphen-separated segment, the deployment suffix (`bundtst-plygrnd-awsaurorapos-rbpt` → `bundtst-plygrnd-awsaurorapos`). This is how `massdriver_instance_alarm` and the `massdriver_instance` data source derive their default `id` from `MASSDRIVER_PACKAGE_NAME`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "package_name",
+				MarkdownDescription: "A package name, such as the `MASSDRIVER_PACKAGE_NAME` a deployment runs with. Must contain at least one hyphen.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (instanceIDFromPackageNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	id, ok := instanceIDFromPackageName(name)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("package name %q has no deployment suffix to strip; it must contain at least one hyphen", name))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, id)
+}
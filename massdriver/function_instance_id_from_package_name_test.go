@@ -0,0 +1,44 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInstanceIDFromPackageNameFunction(t *testing.T) {
+	tests := []struct {
+		packageName string
+		want        string
+		wantErr     string
+	}{
+		{packageName: "bundtst-plygrnd-awsaurorapos-rbpt", want: "bundtst-plygrnd-awsaurorapos"},
+		{packageName: "proj-env-db-0000", want: "proj-env-db"},
+		{packageName: "standalone", wantErr: "no deployment suffix"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.packageName, func(t *testing.T) {
+			got, funcErr := callFunction(t, "instance_id_from_package_name", tc.packageName)
+			if tc.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tc.wantErr) || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+					t.Fatalf("got error %+v, want one on package_name mentioning %q", funcErr, tc.wantErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !got.Equal(tftypes.NewValue(tftypes.String, tc.want)) {
+				t.Errorf("got %v, want %q", got, tc.want)
+			}
+
+			// The function and the resources' default must agree.
+			t.Setenv("MASSDRIVER_INSTANCE_ID", "")
+			t.Setenv("MASSDRIVER_PACKAGE_NAME", tc.packageName)
+			if fromEnv, _ := instanceIDFromEnv(); fromEnv != tc.want {
+				t.Errorf("instanceIDFromEnv returned %v, the function %q", fromEnv, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type qualifyResourceTypeFunction struct{}
+
+var _ function.Function = qualifyResourceTypeFunction{}
+
+func newQualifyResourceTypeFunction() function.Function {
+	return qualifyResourceTypeFunction{}
+}
+
+func (qualifyResourceTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "qualify_resource_type"
+}
+
+func (qualifyResourceTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Resolves a `$ref` to the resource type Massdriver stores",
+		MarkdownDescription: "Turns a resource type reference, as written in a bundle's `massdriver.yaml`, into the fully-qualified type `massdriver_resource` sends to the API. A version pin is dropped (`aws-vpc@1.2.0` → `aws-vpc`) and a bare type ID is prefixed with the organization (`aws-vpc` → `<organization_id>/aws-vpc`). References that already name an organization (`massdriver/aws-vpc`) keep it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The type reference, e.g. `aws-vpc`, `aws-vpc@1.2.0` or `massdriver/aws-vpc`.",
+			},
+			function.StringParameter{
+				Name:                "organization_id",
+				MarkdownDescription: "The organization a bare type ID belongs to, usually the provider's `organization_id`. Only used when `resource_type` has no organization.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (qualifyResourceTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref, orgID string
+	resp.Error = req.Arguments.Get(ctx, &ref, &orgID)
+	if resp.Error != nil {
+		return
+	}
+
+	if ref == "" {
+		resp.Error = function.NewArgumentFuncError(0, "resource_type must not be empty")
+		return
+	}
+	ref = bundle.UnversionedRef(ref)
+	if orgID == "" && !strings.Contains(ref, "/") {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("organization_id must be set to qualify %q, which names no organization", ref))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, prefixOrgIfNeeded(ref, orgID))
+}
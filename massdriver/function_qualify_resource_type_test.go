@@ -0,0 +1,43 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestQualifyResourceTypeFunction(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		orgID        string
+		want         string
+		wantErr      string
+		wantArgument int64
+	}{
+		{name: "bare type", resourceType: "aws-vpc", orgID: "acme", want: "acme/aws-vpc"},
+		{name: "version pin", resourceType: "aws-vpc@1.2.0", orgID: "acme", want: "acme/aws-vpc"},
+		{name: "qualified", resourceType: "massdriver/aws-vpc", orgID: "acme", want: "massdriver/aws-vpc"},
+		{name: "qualified with version pin", resourceType: "massdriver/aws-vpc@latest", want: "massdriver/aws-vpc"},
+		{name: "bare type without organization", resourceType: "aws-vpc", wantErr: "organization_id must be set", wantArgument: 1},
+		{name: "empty", resourceType: "", orgID: "acme", wantErr: "must not be empty", wantArgument: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, funcErr := callFunction(t, "qualify_resource_type", tc.resourceType, tc.orgID)
+			if tc.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tc.wantErr) || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tc.wantArgument {
+					t.Fatalf("got error %+v, want one on argument %d mentioning %q", funcErr, tc.wantArgument, tc.wantErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !got.Equal(tftypes.NewValue(tftypes.String, tc.want)) {
+				t.Errorf("got %v, want %q", got, tc.want)
+			}
+		})
+	}
+}
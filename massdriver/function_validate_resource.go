@@ -0,0 +1,77 @@
+package massdriver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type validateResourceFunction struct{}
+
+var _ function.Function = validateResourceFunction{}
+
+func newValidateResourceFunction() function.Function {
+	return validateResourceFunction{}
+}
+
+func (validateResourceFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_resource"
+}
+
+func (validateResourceFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validates a resource payload against a JSON Schema",
+		MarkdownDescription: "Checks a JSON-encoded payload against a JSON Schema with the same validator `massdriver_resource` runs before sending a payload. Returns `true` when the payload is valid. Otherwise the call fails with one line per violation, giving the JSON pointer, the failing schema keyword and the offending value; values the schema marks `$md.sensitive` are redacted. `#/...` references are resolved within the schema.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema_json",
+				MarkdownDescription: "The JSON Schema, JSON-encoded — for example one field's schema from `schema-artifacts.json`, or the `schema` of the `massdriver_resource_type` data source.",
+			},
+			function.StringParameter{
+				Name:                "payload_json",
+				MarkdownDescription: "The JSON-encoded payload to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (validateResourceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schemaJSON, payloadJSON string
+	resp.Error = req.Arguments.Get(ctx, &schemaJSON, &payloadJSON)
+	if resp.Error != nil {
+		return
+	}
+
+	var jsonSchema map[string]any
+	if err := json.Unmarshal([]byte(schemaJSON), &jsonSchema); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("schema_json is not a JSON object: %s", err))
+		return
+	}
+	if !json.Valid([]byte(payloadJSON)) {
+		resp.Error = function.NewArgumentFuncError(1, "payload_json is not valid JSON")
+		return
+	}
+
+	diags := validateAgainstSchema("resource validation failed", jsonSchema, payloadJSON, nil)
+	if diags.HasError() {
+		// A function error is plain text, so each diagnostic is folded onto
+		// one line. The summary already names the JSON pointer.
+		violations := make([]string, 0, len(diags))
+		for _, d := range diags {
+			var details []string
+			for _, line := range strings.Split(d.Detail, "\n") {
+				if line != "" && !strings.HasPrefix(line, "JSON pointer: ") {
+					details = append(details, line)
+				}
+			}
+			violations = append(violations, d.Summary+": "+strings.Join(details, "; "))
+		}
+		resp.Error = function.NewArgumentFuncError(1, strings.Join(violations, "\n"))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, true)
+}
@@ -0,0 +1,64 @@
+package massdriver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateResourceFunction(t *testing.T) {
+	schemaJSON, err := json.Marshal(credentialsSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, funcErr := callFunction(t, "validate_resource", string(schemaJSON), `{"data":{"username":"admin","password":"correct-horse-battery"}}`)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr.Text)
+	}
+	if !got.Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("got %v, want true", got)
+	}
+
+	_, funcErr = callFunction(t, "validate_resource", string(schemaJSON), `{"data":{"username":"ab","password":"hunter2","port":"5432"}}`)
+	if funcErr == nil {
+		t.Fatal("expected an invalid payload to fail")
+	}
+	if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("the error should point at payload_json, got argument %v", funcErr.FunctionArgument)
+	}
+	if lines := strings.Split(funcErr.Text, "\n"); len(lines) != 3 {
+		t.Errorf("want one line per violation, got %q", funcErr.Text)
+	}
+	for _, want := range []string{"at /data/username", "at /data/password", "at /data/port", "Value: " + redactedValue} {
+		if !strings.Contains(funcErr.Text, want) {
+			t.Errorf("error %q does not contain %q", funcErr.Text, want)
+		}
+	}
+	if strings.Contains(funcErr.Text, "hunter2") {
+		t.Error("sensitive value leaked into the error")
+	}
+}
+
+func TestValidateResourceFunctionRejectsMalformedArguments(t *testing.T) {
+	tests := []struct {
+		name         string
+		schemaJSON   string
+		payloadJSON  string
+		wantArgument int64
+	}{
+		{name: "schema", schemaJSON: `{"type":`, payloadJSON: `{}`, wantArgument: 0},
+		{name: "schema that is not an object", schemaJSON: `["object"]`, payloadJSON: `{}`, wantArgument: 0},
+		{name: "payload", schemaJSON: `{"type":"object"}`, payloadJSON: `{"data":`, wantArgument: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, funcErr := callFunction(t, "validate_resource", tc.schemaJSON, tc.payloadJSON)
+			if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tc.wantArgument {
+				t.Fatalf("got error %+v, want one on argument %d", funcErr, tc.wantArgument)
+			}
+		})
+	}
+}
@@ -3,10 +3,24 @@ package massdriver
 import (
 	"context"
 
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+)
+
+// Values accepted by the provider's `auth_method` attribute.
+const (
+	authMethodDeployment          = "deployment"
+	authMethodAPIKey              = "api_key"
+	authMethodPersonalAccessToken = "personal_access_token"
 )
 
+const defaultMassdriverURL = "https://api.massdriver.cloud"
+
 // Provider -
 //
 // v1.3 is a bridge release. The deprecated resources (`massdriver_artifact`,
@@ -16,21 +30,86 @@ import (
 // removes the deprecated resources entirely.
 func Provider() *schema.Provider {
 	return &schema.Provider{
-		Schema: map[string]*schema.Schema{},
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "Base URL of the Massdriver API. Defaults to the environment variable `MASSDRIVER_URL`, falling back to `https://api.massdriver.cloud`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MASSDRIVER_URL", nil),
+			},
+			"organization_id": {
+				Description: "ID of the Massdriver organization to manage. Defaults to the environment variable `MASSDRIVER_ORGANIZATION_ID`. Required when `api_key` or `access_token` is set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MASSDRIVER_ORGANIZATION_ID", nil),
+			},
+			"api_key": {
+				Description: "Organization API key. Defaults to the environment variable `MASSDRIVER_API_KEY`. Conflicts with `access_token`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MASSDRIVER_API_KEY", nil),
+			},
+			"access_token": {
+				Description: "Personal access token. Defaults to the environment variable `MASSDRIVER_ACCESS_TOKEN`. Conflicts with `api_key`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MASSDRIVER_ACCESS_TOKEN", nil),
+			},
+			"auth_method": {
+				Description: "Which credentials to authenticate with: `api_key`, `personal_access_token` or `deployment`. Inferred from whichever of `api_key` / `access_token` is set when omitted. `deployment` uses the deployment-scoped credentials Massdriver injects into bundle deployments and cannot be combined with `api_key` or `access_token`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					authMethodDeployment,
+					authMethodAPIKey,
+					authMethodPersonalAccessToken,
+				}, false),
+			},
+			"resource_type_schema_dir": {
+				Description: "Directory of resource type schemas, laid out as `<org>/<type>.json`, used to resolve `$ref`s such as `massdriver/aws-vpc` in a bundle's `schema-artifacts.json` without calling the API. Defaults to the environment variable `MASSDRIVER_RESOURCE_TYPE_SCHEMA_DIR`. When unset, a schema that references a resource type fails validation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MASSDRIVER_RESOURCE_TYPE_SCHEMA_DIR", nil),
+			},
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"massdriver_artifact":       resourceArtifact(),
-			"massdriver_package_alarm":  resourcePackageAlarm(),
-			"massdriver_resource":       resourceResource(),
-			"massdriver_instance_alarm": resourceInstanceAlarm(),
+			"massdriver_artifact":            resourceArtifact(),
+			"massdriver_package_alarm":       resourcePackageAlarm(),
+			"massdriver_resource":            resourceResource(),
+			"massdriver_project":             resourceProject(),
+			"massdriver_environment":         resourceEnvironment(),
+			"massdriver_component":           resourceComponent(),
+			"massdriver_link":                resourceLink(),
+			"massdriver_environment_default": resourceEnvironmentDefault(),
+			"massdriver_remote_reference":    resourceRemoteReference(),
+			"massdriver_imported_resource":   resourceImportedResource(),
+			"massdriver_resource_type":       resourceResourceType(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"massdriver_instance":      dataSourceInstance(),
+			"massdriver_resource":      dataSourceResource(),
+			"massdriver_resources":     dataSourceResources(),
+			"massdriver_resource_type": dataSourceResourceType(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	client, clientErr := NewProviderClient()
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var client *ProviderClient
+	var clientErr error
+	if cfg == nil {
+		client, clientErr = NewProviderClient()
+	} else {
+		client, clientErr = NewProviderClientWithConfig(cfg)
+	}
 	if clientErr != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -40,5 +119,131 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
+	if dir := d.Get("resource_type_schema_dir").(string); dir != "" {
+		client.typeRefs = bundle.DirResolver(dir)
+	}
+
 	return client, diags
 }
+
+// providerConfig translates the provider block into an SDK config. It returns
+// a nil config (and no errors) when the block carries no credentials, so the
+// caller falls back to the SDK's own environment/profile resolution — that
+// keeps bundle deployments, which only receive deployment-scoped env vars,
+// working with an empty `provider "massdriver" {}` block.
+func providerConfig(d *schema.ResourceData) (*config.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	url := d.Get("url").(string)
+	orgID := d.Get("organization_id").(string)
+	apiKey := d.Get("api_key").(string)
+	accessToken := d.Get("access_token").(string)
+	method := d.Get("auth_method").(string)
+
+	if apiKey != "" && accessToken != "" {
+		return nil, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Conflicting Massdriver credentials",
+			Detail:        "Only one of `api_key` and `access_token` may be set (either in the provider block or via MASSDRIVER_API_KEY / MASSDRIVER_ACCESS_TOKEN).",
+			AttributePath: cty.GetAttrPath("access_token"),
+		})
+	}
+
+	if method == "" {
+		switch {
+		case apiKey != "":
+			method = authMethodAPIKey
+		case accessToken != "":
+			method = authMethodPersonalAccessToken
+		}
+	}
+
+	var credentials *config.Credentials
+	switch method {
+	case "":
+		// Nothing configured: defer to the SDK so URL/org-only overrides
+		// don't accidentally drop credentials it would have found itself.
+		if url == "" && orgID == "" {
+			return nil, diags
+		}
+	case authMethodDeployment:
+		if apiKey != "" || accessToken != "" {
+			return nil, append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid Massdriver credentials",
+				Detail:        "`auth_method = \"deployment\"` uses the credentials injected into a Massdriver deployment and cannot be combined with `api_key` or `access_token`.",
+				AttributePath: cty.GetAttrPath("auth_method"),
+			})
+		}
+		// Deployment credentials only exist in the SDK's environment
+		// lookup; `url` and `organization_id` are layered over it below.
+		if url == "" && orgID == "" {
+			return nil, diags
+		}
+	case authMethodAPIKey:
+		if apiKey == "" {
+			return nil, append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Missing Massdriver API key",
+				Detail:        "`auth_method = \"api_key\"` requires `api_key` (or MASSDRIVER_API_KEY) to be set.",
+				AttributePath: cty.GetAttrPath("api_key"),
+			})
+		}
+		credentials = &config.Credentials{Method: config.AuthAPIKey, ID: orgID, Secret: apiKey}
+	case authMethodPersonalAccessToken:
+		if accessToken == "" {
+			return nil, append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Missing Massdriver access token",
+				Detail:        "`auth_method = \"personal_access_token\"` requires `access_token` (or MASSDRIVER_ACCESS_TOKEN) to be set.",
+				AttributePath: cty.GetAttrPath("access_token"),
+			})
+		}
+		credentials = &config.Credentials{Method: config.AuthPAT, ID: orgID, Secret: accessToken}
+	}
+
+	if credentials != nil && orgID == "" {
+		return nil, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Missing Massdriver organization",
+			Detail:        "`organization_id` (or MASSDRIVER_ORGANIZATION_ID) must be set when authenticating with `api_key` or `access_token`.",
+			AttributePath: cty.GetAttrPath("organization_id"),
+		})
+	}
+
+	if credentials != nil {
+		// Explicit credentials build a config from scratch rather than
+		// layering over the SDK's environment lookup, so nothing from the
+		// process environment leaks into this provider instance.
+		if url == "" {
+			url = defaultMassdriverURL
+		}
+		return &config.Config{
+			URL:            url,
+			OrganizationID: orgID,
+			Credentials:    credentials,
+		}, diags
+	}
+
+	// Only `url` and/or `organization_id` were given (or the deployment
+	// credentials were asked for): start from the SDK's environment/profile
+	// config so its credentials are kept.
+	base, err := config.Get()
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to load Massdriver configuration",
+			Detail:   err.Error(),
+		})
+	}
+	// The SDK may hand back a shared config; copy it before overriding
+	// fields so one aliased instance can't rewrite another's settings.
+	cfg := cloneConfig(base)
+	if url != "" {
+		cfg.URL = url
+	}
+	if orgID != "" {
+		cfg.OrganizationID = orgID
+	}
+	return cfg, diags
+}
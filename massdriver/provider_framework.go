@@ -0,0 +1,215 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknownVariableValue is how the SDK's legacy config representation spells
+// a value that isn't known until apply.
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// ProviderServer returns the protocol 5 server Terraform talks to: the SDKv2
+// provider from Provider() and the plugin framework provider muxed together.
+// Every resource and data source type is served by exactly one of the two;
+// resources move to the framework one at a time by leaving ResourcesMap and
+// joining frameworkProvider.Resources.
+func ProviderServer(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider, fwProvider := newMuxedProviders(version)
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(fwProvider),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return mux.ProviderServer, nil
+}
+
+// newMuxedProviders returns the two halves ProviderServer serves, configured
+// through one sharedProviderClient so every resource, whichever half serves
+// it, talks to the same ProviderClient and its resource type schema cache.
+func newMuxedProviders(version string) (*schema.Provider, *frameworkProvider) {
+	shared := &sharedProviderClient{}
+	sdkProvider := Provider()
+	sdkProvider.ConfigureContextFunc = shared.configure
+	return sdkProvider, &frameworkProvider{version: version, shared: shared}
+}
+
+// sharedProviderClient runs providerConfigure once per provider server. The
+// mux configures each half with the same provider block, so whichever half
+// is configured first builds the client and the other reuses it.
+type sharedProviderClient struct {
+	mu     sync.Mutex
+	done   bool
+	client any
+	diags  diag.Diagnostics
+}
+
+func (s *sharedProviderClient) configure(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.done {
+		s.client, s.diags = providerConfigure(ctx, d)
+		s.done = true
+	}
+	return s.client, s.diags
+}
+
+// frameworkProvider is the plugin framework half of the provider. Its
+// provider block is the SDKv2 one — the mux requires the two schemas to be
+// identical — and configuring it runs the SDKv2 provider's configure step,
+// so both halves resolve credentials and environment defaults the same way.
+type frameworkProvider struct {
+	version string
+
+	// meta, when set, is handed to resources as-is instead of building a
+	// client from the provider block. It is the framework counterpart of
+	// schema.Provider.SetMeta, for tests.
+	meta *ProviderClient
+
+	// shared replaces the SDKv2 provider's configure function so the client
+	// is the one the SDKv2 half uses. See newMuxedProviders.
+	shared *sharedProviderClient
+}
+
+var _ provider.ProviderWithFunctions = (*frameworkProvider)(nil)
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "massdriver"
+	resp.Version = p.version
+}
+
+// Schema mirrors the SDKv2 provider block attribute for attribute. Validation
+// of the block is left to the SDKv2 half, so users see each error once.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := map[string]fwschema.Attribute{}
+	for name, s := range Provider().Schema {
+		if s.Type != schema.TypeString {
+			resp.Diagnostics.AddError("Unsupported provider attribute", fmt.Sprintf("provider attribute %q has type %s; only strings can be mirrored into the plugin framework provider", name, s.Type))
+			continue
+		}
+		attributes[name] = fwschema.StringAttribute{
+			Description: s.Description,
+			Optional:    s.Optional,
+			Required:    s.Required,
+			Sensitive:   s.Sensitive,
+		}
+	}
+	resp.Schema = fwschema.Schema{Attributes: attributes}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if p.meta != nil {
+		resp.ResourceData = p.meta
+		return
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := req.Config.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError("Unable to read the provider configuration", err.Error())
+		return
+	}
+	raw := map[string]any{}
+	for name, v := range attributes {
+		switch {
+		case !v.IsKnown():
+			raw[name] = unknownVariableValue
+		case !v.IsNull():
+			var s string
+			if err := v.As(&s); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Unable to read the provider configuration", err.Error())
+				return
+			}
+			raw[name] = s
+		}
+	}
+
+	sdkProvider := Provider()
+	sdkProvider.ConfigureContextFunc = p.shared.configure
+	diags := sdkProvider.Configure(ctx, terraform.NewResourceConfigRaw(raw))
+	resp.Diagnostics.Append(frameworkDiagnostics(diags)...)
+	if diags.HasError() {
+		return
+	}
+	resp.ResourceData = sdkProvider.Meta()
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newInstanceAlarmResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// Functions share their implementation with the resources that apply the
+// same rules, so HCL and the provider cannot disagree.
+func (p *frameworkProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		newInstanceIDFromPackageNameFunction,
+		newQualifyResourceTypeFunction,
+		newValidateResourceFunction,
+	}
+}
+
+// frameworkDiagnostics converts SDKv2 diagnostics so shared helpers written
+// against the SDK can report through framework resources. Attribute paths
+// keep their leading attribute name, which is all the helpers ever set.
+func frameworkDiagnostics(diags diag.Diagnostics) fwdiag.Diagnostics {
+	var out fwdiag.Diagnostics
+	for _, d := range diags {
+		var attr path.Path
+		if len(d.AttributePath) > 0 {
+			if step, ok := d.AttributePath[0].(cty.GetAttrStep); ok {
+				attr = path.Root(step.Name)
+			}
+		}
+		switch {
+		case d.Severity == diag.Warning && len(attr.Steps()) > 0:
+			out.AddAttributeWarning(attr, d.Summary, d.Detail)
+		case d.Severity == diag.Warning:
+			out.AddWarning(d.Summary, d.Detail)
+		case len(attr.Steps()) > 0:
+			out.AddAttributeError(attr, d.Summary, d.Detail)
+		default:
+			out.AddError(d.Summary, d.Detail)
+		}
+	}
+	return out
+}
+
+// providerClient extracts the *ProviderClient a framework resource receives
+// in Configure. Terraform may configure resources before the provider block
+// is known, in which case there is nothing to extract yet and nil is
+// returned without an error.
+func providerClient(data any) (*ProviderClient, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	if data == nil {
+		return nil, diags
+	}
+	pc, ok := data.(*ProviderClient)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("expected *ProviderClient, got %T", data))
+	}
+	return pc, diags
+}
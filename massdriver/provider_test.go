@@ -4,7 +4,16 @@ import (
 	"os"
 	"testing"
 
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -35,3 +44,443 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("MASSDRIVER_TOKEN must be set for acceptance tests")
 	}
 }
+
+// clearProviderEnv blanks every env var the provider block falls back to so
+// the developer's shell can't leak into configuration tests.
+func clearProviderEnv(t *testing.T) {
+	t.Helper()
+	for _, k := range []string{
+		"MASSDRIVER_URL",
+		"MASSDRIVER_ORGANIZATION_ID",
+		"MASSDRIVER_API_KEY",
+		"MASSDRIVER_ACCESS_TOKEN",
+		"MASSDRIVER_RESOURCE_TYPE_SCHEMA_DIR",
+	} {
+		t.Setenv(k, "")
+	}
+}
+
+func TestProviderConfigAPIKey(t *testing.T) {
+	clearProviderEnv(t)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"organization_id": "acme",
+		"api_key":         "md-key",
+		"url":             "https://md.example.com",
+	})
+
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if cfg == nil {
+		t.Fatal("expected an explicit config, got nil (SDK fallback)")
+	}
+	if cfg.OrganizationID != "acme" || cfg.URL != "https://md.example.com" {
+		t.Errorf("got org %q url %q", cfg.OrganizationID, cfg.URL)
+	}
+	if cfg.Credentials == nil || cfg.Credentials.Method != config.AuthAPIKey || cfg.Credentials.Secret != "md-key" {
+		t.Errorf("got credentials %+v, want api_key md-key", cfg.Credentials)
+	}
+}
+
+func TestProviderConfigAccessTokenDefaultsURL(t *testing.T) {
+	clearProviderEnv(t)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"organization_id": "acme",
+		"access_token":    "pat-123",
+	})
+
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if cfg.URL != defaultMassdriverURL {
+		t.Errorf("got url %q, want %s", cfg.URL, defaultMassdriverURL)
+	}
+	if cfg.Credentials == nil || cfg.Credentials.Method != config.AuthPAT {
+		t.Errorf("got credentials %+v, want personal access token", cfg.Credentials)
+	}
+}
+
+func TestProviderConfigReadsEnvFallbacks(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv("MASSDRIVER_ORGANIZATION_ID", "env-org")
+	t.Setenv("MASSDRIVER_API_KEY", "env-key")
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{})
+
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if cfg.OrganizationID != "env-org" || cfg.Credentials.Secret != "env-key" {
+		t.Errorf("got org %q secret %q, want env-org / env-key", cfg.OrganizationID, cfg.Credentials.Secret)
+	}
+}
+
+// An empty provider block must keep deferring to the SDK's own environment
+// lookup — that is how bundle deployments authenticate.
+func TestProviderConfigEmptyBlockFallsBackToSDK(t *testing.T) {
+	clearProviderEnv(t)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{})
+
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if cfg != nil {
+		t.Errorf("expected nil config for an empty block, got %+v", cfg)
+	}
+}
+
+// Deployment credentials come from the SDK's environment lookup, but the
+// block's `url` and `organization_id` must still apply on top of them.
+func TestProviderConfigDeploymentKeepsOverrides(t *testing.T) {
+	clearProviderEnv(t)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"auth_method":     "deployment",
+		"organization_id": "acme",
+		"url":             "https://md.example.com",
+	})
+
+	cfg, diags := providerConfig(d)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if cfg == nil {
+		t.Fatal("expected url and organization_id to be applied, got nil (SDK fallback)")
+	}
+	if cfg.OrganizationID != "acme" || cfg.URL != "https://md.example.com" {
+		t.Errorf("got org %q url %q", cfg.OrganizationID, cfg.URL)
+	}
+}
+
+func TestProviderConfigRejectsInvalidCombinations(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]any
+		path string
+	}{
+		{
+			name: "api_key_and_access_token",
+			raw:  map[string]any{"organization_id": "acme", "api_key": "k", "access_token": "t"},
+			path: "access_token",
+		},
+		{
+			name: "api_key_without_org",
+			raw:  map[string]any{"api_key": "k"},
+			path: "organization_id",
+		},
+		{
+			name: "api_key_method_without_key",
+			raw:  map[string]any{"organization_id": "acme", "auth_method": "api_key"},
+			path: "api_key",
+		},
+		{
+			name: "pat_method_with_api_key",
+			raw:  map[string]any{"organization_id": "acme", "auth_method": "personal_access_token", "api_key": "k"},
+			path: "access_token",
+		},
+		{
+			name: "deployment_method_with_api_key",
+			raw:  map[string]any{"organization_id": "acme", "auth_method": "deployment", "api_key": "k"},
+			path: "auth_method",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			clearProviderEnv(t)
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+
+			_, diags := providerConfig(d)
+			if !diags.HasError() {
+				t.Fatal("expected an error diagnostic, got none")
+			}
+			if got := diags[0].AttributePath; !got.Equals(cty.GetAttrPath(tc.path)) {
+				t.Errorf("got attribute path %#v, want %s", got, tc.path)
+			}
+		})
+	}
+}
+
+// Two `provider "massdriver"` blocks (one aliased) configured in the same
+// process must each get their own client, org and credentials.
+func TestProviderAliasesAreIsolated(t *testing.T) {
+	clearProviderEnv(t)
+
+	shared := Provider()
+	if diags := shared.Configure(t.Context(), terraform.NewResourceConfigRaw(map[string]any{
+		"organization_id": "shared-org",
+		"api_key":         "shared-key",
+		"url":             "https://shared.example.com",
+	})); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	tenant := Provider()
+	if diags := tenant.Configure(t.Context(), terraform.NewResourceConfigRaw(map[string]any{
+		"organization_id": "tenant-org",
+		"access_token":    "tenant-token",
+	})); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	sharedClient := shared.Meta().(*ProviderClient).Client
+	tenantClient := tenant.Meta().(*ProviderClient).Client
+
+	if sharedClient == tenantClient {
+		t.Fatal("provider instances share a *client.Client")
+	}
+	if sharedClient.Config.Credentials == tenantClient.Config.Credentials {
+		t.Fatal("provider instances share a *config.Credentials")
+	}
+	if sharedClient.Config.OrganizationID != "shared-org" || tenantClient.Config.OrganizationID != "tenant-org" {
+		t.Errorf("got orgs %q / %q, want shared-org / tenant-org",
+			sharedClient.Config.OrganizationID, tenantClient.Config.OrganizationID)
+	}
+	if sharedClient.Config.URL != "https://shared.example.com" || tenantClient.Config.URL != defaultMassdriverURL {
+		t.Errorf("got urls %q / %q", sharedClient.Config.URL, tenantClient.Config.URL)
+	}
+	if sharedClient.Config.Credentials.Secret != "shared-key" || tenantClient.Config.Credentials.Secret != "tenant-token" {
+		t.Error("credentials leaked between provider instances")
+	}
+}
+
+func TestProviderConfigureResourceTypeSchemaDir(t *testing.T) {
+	clearProviderEnv(t)
+
+	p := Provider()
+	if diags := p.Configure(t.Context(), terraform.NewResourceConfigRaw(map[string]any{
+		"organization_id":          "acme",
+		"api_key":                  "md-key",
+		"resource_type_schema_dir": "/bundle/types",
+	})); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := p.Meta().(*ProviderClient).typeRefs; got != bundle.DirResolver("/bundle/types") {
+		t.Errorf("got resolver %#v, want a DirResolver for /bundle/types", got)
+	}
+
+	unset := Provider()
+	if diags := unset.Configure(t.Context(), terraform.NewResourceConfigRaw(map[string]any{
+		"organization_id": "acme",
+		"api_key":         "md-key",
+	})); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := unset.Meta().(*ProviderClient).typeRefs; got != nil {
+		t.Errorf("got resolver %#v, want none when the directory is unset", got)
+	}
+}
+
+// Each auth_method must reach the SDK client as the matching credentials,
+// whether it is set explicitly or inferred from the credential attribute.
+func TestProviderConfigureAuthMethods(t *testing.T) {
+	cases := []struct {
+		name       string
+		raw        map[string]any
+		wantMethod config.AuthMethod
+		wantSecret string
+		wantURL    string
+	}{
+		{
+			name:       "api_key",
+			raw:        map[string]any{"auth_method": "api_key", "organization_id": "acme", "api_key": "md-key"},
+			wantMethod: config.AuthAPIKey,
+			wantSecret: "md-key",
+			wantURL:    defaultMassdriverURL,
+		},
+		{
+			name:       "api_key_inferred",
+			raw:        map[string]any{"organization_id": "acme", "api_key": "md-key", "url": "https://md.example.com"},
+			wantMethod: config.AuthAPIKey,
+			wantSecret: "md-key",
+			wantURL:    "https://md.example.com",
+		},
+		{
+			name:       "personal_access_token",
+			raw:        map[string]any{"auth_method": "personal_access_token", "organization_id": "acme", "access_token": "pat-123"},
+			wantMethod: config.AuthPAT,
+			wantSecret: "pat-123",
+			wantURL:    defaultMassdriverURL,
+		},
+		{
+			name:       "personal_access_token_inferred",
+			raw:        map[string]any{"organization_id": "acme", "access_token": "pat-123", "url": "https://md.example.com"},
+			wantMethod: config.AuthPAT,
+			wantSecret: "pat-123",
+			wantURL:    "https://md.example.com",
+		},
+		{
+			name:    "deployment",
+			raw:     map[string]any{"auth_method": "deployment", "organization_id": "acme", "url": "https://md.example.com"},
+			wantURL: "https://md.example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			clearProviderEnv(t)
+			t.Setenv("MASSDRIVER_DEPLOYMENT_ID", "deploy-1")
+			t.Setenv("MASSDRIVER_TOKEN", "deploy-token")
+
+			p := Provider()
+			if diags := p.Configure(t.Context(), terraform.NewResourceConfigRaw(tc.raw)); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			cfg := p.Meta().(*ProviderClient).Client.Config
+
+			if cfg.OrganizationID != "acme" || cfg.URL != tc.wantURL {
+				t.Errorf("got org %q url %q, want acme %s", cfg.OrganizationID, cfg.URL, tc.wantURL)
+			}
+			if tc.wantMethod == "" {
+				if cfg.Credentials != nil && (cfg.Credentials.Method == config.AuthAPIKey || cfg.Credentials.Method == config.AuthPAT) {
+					t.Errorf("got credentials %+v, want the SDK's deployment credentials", cfg.Credentials)
+				}
+				return
+			}
+			if cfg.Credentials == nil || cfg.Credentials.Method != tc.wantMethod || cfg.Credentials.Secret != tc.wantSecret {
+				t.Fatalf("got credentials %+v, want %s %s", cfg.Credentials, tc.wantMethod, tc.wantSecret)
+			}
+			if cfg.Credentials.ID != "acme" {
+				t.Errorf("got credential ID %q, want the organization acme", cfg.Credentials.ID)
+			}
+		})
+	}
+}
+
+// Mutating the config a client was built from must not reach into the
+// client — otherwise a later alias reusing the struct would rewrite it.
+func TestNewProviderClientWithConfigCopiesCredentials(t *testing.T) {
+	cfg := &config.Config{
+		OrganizationID: "acme",
+		URL:            defaultMassdriverURL,
+		Credentials:    &config.Credentials{Method: config.AuthAPIKey, Secret: "first"},
+	}
+	pc, err := NewProviderClientWithConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.OrganizationID = "other"
+	cfg.Credentials.Secret = "second"
+
+	if pc.Client.Config.OrganizationID != "acme" {
+		t.Errorf("got org %q, want acme", pc.Client.Config.OrganizationID)
+	}
+	if pc.Client.Config.Credentials.Secret != "first" {
+		t.Errorf("got secret %q, want first", pc.Client.Config.Credentials.Secret)
+	}
+}
+
+// The muxed server only works if both halves declare the same provider block
+// and no resource type is claimed by both.
+func TestProviderServerMuxesSDKv2AndFramework(t *testing.T) {
+	factory, err := ProviderServer(t.Context(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := factory().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNoDiagnostics(t, schemas.Diagnostics)
+
+	for _, typeName := range []string{"massdriver_instance_alarm", "massdriver_resource", "massdriver_package_alarm"} {
+		if _, ok := schemas.ResourceSchemas[typeName]; !ok {
+			t.Errorf("%s is not served", typeName)
+		}
+	}
+	if _, ok := Provider().ResourcesMap["massdriver_instance_alarm"]; ok {
+		t.Error("massdriver_instance_alarm is served by the framework and must leave the SDKv2 ResourcesMap")
+	}
+	for _, name := range []string{"instance_id_from_package_name", "qualify_resource_type", "validate_resource"} {
+		if _, ok := schemas.Functions[name]; !ok {
+			t.Errorf("function %s is not served", name)
+		}
+	}
+}
+
+// Both halves of the muxed server must hand their resources the same client,
+// so the resource type schema cache isn't built twice.
+func TestMuxedProvidersShareOneClient(t *testing.T) {
+	clearProviderEnv(t)
+	sdkProvider, fwProvider := newMuxedProviders("test")
+
+	if diags := sdkProvider.Configure(t.Context(), terraform.NewResourceConfigRaw(map[string]any{
+		"organization_id": "acme",
+		"api_key":         "md-key",
+	})); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var schemaResp provider.SchemaResponse
+	fwProvider.Schema(t.Context(), provider.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(t.Context())
+	raw, err := dynamicValue(t, typ, `{"organization_id":"acme","api_key":"md-key"}`).Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp provider.ConfigureResponse
+	fwProvider.Configure(t.Context(), provider.ConfigureRequest{
+		Config: tfsdk.Config{Raw: raw, Schema: schemaResp.Schema},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if resp.ResourceData == nil || resp.ResourceData != sdkProvider.Meta() {
+		t.Errorf("framework half got client %p, SDKv2 half %p; want one shared client", resp.ResourceData, sdkProvider.Meta())
+	}
+}
+
+// The framework half configures through the SDKv2 provider, so the same
+// block — environment defaults included — is accepted or rejected the same
+// way by both.
+func TestFrameworkProviderConfigure(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		config   string
+		wantErr  string
+		wantPath string
+	}{
+		{name: "explicit credentials", config: `{"organization_id":"acme","api_key":"md-key"}`},
+		{name: "conflicting credentials", config: `{"organization_id":"acme","api_key":"md-key","access_token":"pat"}`, wantErr: "Conflicting Massdriver credentials", wantPath: "access_token"},
+		{name: "conflict through the environment", env: map[string]string{"MASSDRIVER_API_KEY": "md-key", "MASSDRIVER_ACCESS_TOKEN": "pat"}, config: `{}`, wantErr: "Conflicting Massdriver credentials", wantPath: "access_token"},
+		{name: "missing organization", config: `{"api_key":"md-key"}`, wantErr: "Missing Massdriver organization", wantPath: "organization_id"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clearProviderEnv(t)
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			_, fwProvider := newMuxedProviders("test")
+			server := providerserver.NewProtocol5(fwProvider)()
+			schemas, err := server.GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := server.ConfigureProvider(t.Context(), &tfprotov5.ConfigureProviderRequest{
+				Config: dynamicValue(t, schemas.Provider.ValueType(), tc.config),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantErr == "" {
+				requireNoDiagnostics(t, resp.Diagnostics)
+				return
+			}
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(resp.Diagnostics), resp.Diagnostics)
+			}
+			d := resp.Diagnostics[0]
+			if d.Severity != tfprotov5.DiagnosticSeverityError || d.Summary != tc.wantErr {
+				t.Errorf("got %v %q, want error %q", d.Severity, d.Summary, tc.wantErr)
+			}
+			if got := d.Attribute.String(); got != `AttributeName("`+tc.wantPath+`")` {
+				t.Errorf("got attribute %s, want %s", got, tc.wantPath)
+			}
+		})
+	}
+}
@@ -6,48 +6,56 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/services/artifacts"
-	"github.com/xeipuuv/gojsonschema"
-	"gopkg.in/yaml.v2"
 )
 
 const DEFAULT_ARTIFACT_SCHEMA_PATH = "../schema-artifacts.json"
 const DEFAULT_SPECIFICATION_PATH = "../massdriver.yaml"
 
-type ArtifactSchema struct {
-	Properties map[string]interface{} `json:"properties"`
-}
-
-type BundleSpecification struct {
-	Artifacts ArtifactSpecification `yaml:"artifacts"`
-}
-
-type ArtifactSpecification struct {
-	Properties map[string]map[string]string `json:"properties" yaml:"properties"`
-}
-
 func resourceArtifact() *schema.Resource {
 	return &schema.Resource{
 		Description:        "A Massdriver artifact for exporting a connectable type",
 		DeprecationMessage: "massdriver_artifact is deprecated and will be removed in v2.0 of the massdriver provider. Use `massdriver_resource` instead. Do not manage the same record via both `massdriver_artifact` and `massdriver_resource` — terraform will not detect the conflict and the two resources will fight over state.",
 
 		CreateContext: resourceArtifactCreate,
-		ReadContext:   schema.NoopContext,
+		ReadContext:   resourceArtifactRead,
 		UpdateContext: resourceArtifactUpdate,
 		DeleteContext: resourceArtifactDelete,
+		CustomizeDiff: resourceArtifactCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArtifactImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"artifact": {
-				Description: "A json formatted string containing the artifact.",
+				Description:  "A json formatted string containing the artifact. Stored in state; use `artifact_wo` to keep it out. Exactly one of `artifact` and `artifact_wo` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"artifact", "artifact_wo"},
+			},
+			"artifact_wo": {
+				Description:  "Write-only alternative to `artifact`, requiring Terraform 1.11 or later. The artifact is validated and sent the same way but never written to the plan or state; changes are detected through `payload_sha256`, which can't see values marked `$md.sensitive`, so change `artifact_wo_version` along with them.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"artifact", "artifact_wo"},
+			},
+			"artifact_wo_version": {
+				Description: "Any value; changing it sends `artifact_wo` again. Needed when only values marked `$md.sensitive` change, since those are masked before `payload_sha256` is computed.",
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Optional:    true,
 			},
 			"field": {
 				Description: "The name of this artifact. Must match the name given to this artifact in the massdriver.yaml file.",
@@ -66,6 +74,11 @@ func resourceArtifact() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"payload_sha256": {
+				Description: "SHA-256 of the artifact last sent to Massdriver, in canonical JSON form (keys sorted, insignificant whitespace removed) with values `schema_path` marks `$md.sensitive` replaced by `[SENSITIVE]`, as the API masks them. A plan compares it with the digest of the configured `artifact` or `artifact_wo` and updates the artifact when they differ. Unlike `massdriver_resource`, it is not refreshed from Massdriver.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"provider_resource_id": {
 				Description: "An cloud identifier (AWS ARN, Google/Azure ID) for the primary resource this bundle creates.",
 				Type:        schema.TypeString,
@@ -73,6 +86,11 @@ func resourceArtifact() *schema.Resource {
 				Default:     "",
 				Deprecated:  "This field is deprecated and will be removed in a future version.",
 			},
+			"resource_type": {
+				Description: "The resource type Massdriver stores the artifact as (e.g. `<organization>/aws-vpc`), looked up from `massdriver.yaml` on create and refreshed from Massdriver on every read.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"schema_path": {
 				Description: "The path to the schema-artifacts.json file in order to perform JSON Schema validation on the artifact before sending to Massdriver. This value should only ever be changed when doing local provider testing.",
 				Type:        schema.TypeString,
@@ -102,14 +120,15 @@ func resourceArtifactCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	var diags diag.Diagnostics
 
-	err := validateArtifact(d)
-	if err != nil {
-		return diag.FromErr(err)
+	diags = validateArtifact(d, meta.(*ProviderClient).typeRefs)
+	if diags.HasError() {
+		return diags
 	}
 
-	artifact, err := generateArtifact(d, meta.(*ProviderClient).Client)
-	if err != nil {
-		return diag.FromErr(err)
+	artifact, artifactDiags := generateArtifact(d, meta.(*ProviderClient).Client)
+	diags = append(diags, artifactDiags...)
+	if diags.HasError() {
+		return diags
 	}
 
 	resp, createErr := service.CreateArtifact(ctx, artifact)
@@ -118,23 +137,63 @@ func resourceArtifactCreate(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	d.SetId(resp.ID)
+	d.Set("resource_type", artifact.Type)
+	if err := setArtifactDigest(d, meta.(*ProviderClient), artifact.Payload); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 	return diags
 }
 
+// resourceArtifactRead refreshes the artifact through the resources endpoint,
+// since artifacts are stored as resources server-side. Artifacts created
+// before the server assigned IDs keep their timestamp ID in state and are
+// looked up by the ID getID derives for them.
+func resourceArtifactRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	pc := meta.(*ProviderClient)
+
+	if requireDeploymentAuth(pc.Client) != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "massdriver_artifact was not refreshed",
+			Detail:   "Artifacts can only be read inside a Massdriver bundle deployment (MASSDRIVER_DEPLOYMENT_TOKEN must be set). The existing state is kept as is.",
+		}}
+	}
+	// getID needs the package name to translate a legacy ID. Looking up a
+	// half-built ID would report the artifact as gone and drop it from state.
+	if isLegacyTimestampID(d.Id()) && os.Getenv("MASSDRIVER_PACKAGE_NAME") == "" {
+		return nil
+	}
+
+	got, err := pc.ResourceService().GetResource(ctx, getID(d))
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("field", got.Field)
+	d.Set("name", got.Name)
+	d.Set("resource_type", got.Type)
+	return nil
+}
+
 func resourceArtifactUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	service := meta.(*ProviderClient).ArtifactService()
 
 	var diags diag.Diagnostics
 
-	err := validateArtifact(d)
-	if err != nil {
-		return diag.FromErr(err)
+	diags = validateArtifact(d, meta.(*ProviderClient).typeRefs)
+	if diags.HasError() {
+		return diags
 	}
 
-	artifact, err := generateArtifact(d, meta.(*ProviderClient).Client)
-	if err != nil {
-		return diag.FromErr(err)
+	artifact, artifactDiags := generateArtifact(d, meta.(*ProviderClient).Client)
+	diags = append(diags, artifactDiags...)
+	if diags.HasError() {
+		return diags
 	}
 
 	_, updateErr := service.UpdateArtifact(ctx, getID(d), artifact)
@@ -142,6 +201,10 @@ func resourceArtifactUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(updateErr)
 	}
 
+	d.Set("resource_type", artifact.Type)
+	if err := setArtifactDigest(d, meta.(*ProviderClient), artifact.Payload); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 
 	return diags
@@ -165,112 +228,153 @@ func resourceArtifactDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
+// resourceArtifactImport adopts an existing artifact by ID. Artifacts are
+// stored as resources server-side and the artifacts service has no lookup, so
+// the record is read through the resources endpoint, which (like every
+// artifact write) needs deployment credentials. As for massdriver_resource,
+// only the payload's digest is imported, so an `artifact_wo` config never
+// puts the payload in state. Without the bundle's schema to mask it, the
+// digest is left unset and the first apply sends the configured artifact.
+func resourceArtifactImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	pc := meta.(*ProviderClient)
+
+	if requireDeploymentAuth(pc.Client) != nil {
+		return nil, errors.New("massdriver_artifact can only be imported inside a Massdriver bundle deployment (MASSDRIVER_DEPLOYMENT_TOKEN must be set)")
+	}
+
+	got, err := pc.ResourceService().GetResource(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unable to import artifact %s: %w", d.Id(), err)
+	}
+
+	d.Set("field", got.Field)
+	d.Set("name", got.Name)
+	d.Set("resource_type", got.Type)
+	d.Set("provider_resource_id", "")
+	d.Set("type", "")
+	d.Set("schema_path", DEFAULT_ARTIFACT_SCHEMA_PATH)
+	d.Set("specification_path", DEFAULT_SPECIFICATION_PATH)
+	if got.Payload != nil {
+		setArtifactDigest(d, pc, got.Payload)
+	}
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return []*schema.ResourceData{d}, nil
+}
+
 func getID(d *schema.ResourceData) string {
 	artifactID := d.Id()
 
 	// If the ID is a timestamp, it was from the older system where we didn't have IDs. We need to convert the ID to the new format, which is <package_name>-<field>
-	if _, err := time.Parse(time.RFC3339, artifactID); err == nil {
+	if isLegacyTimestampID(artifactID) {
 		packageName := os.Getenv("MASSDRIVER_PACKAGE_NAME")
 		artifactID = fmt.Sprintf("%s-%s", packageName, d.Get("field"))
 	}
 	return artifactID
 }
 
-func validateArtifact(d *schema.ResourceData) error {
-	artifact := d.Get("artifact").(string)
+func validateArtifact(d *schema.ResourceData, types bundle.RefResolver) diag.Diagnostics {
+	attr, artifact := artifactPayload(d)
 	field := d.Get("field").(string)
 	schemaPath := d.Get("schema_path").(string)
 	if schemaPath == "" {
 		schemaPath = DEFAULT_ARTIFACT_SCHEMA_PATH
 	}
 
-	schemaBytes, err := os.ReadFile(schemaPath)
-	if err != nil {
-		return errors.New(`Unable to open schema file: ` + schemaPath)
+	return validateBundleField("artifact validation failed", field, artifact, schemaPath, types, cty.GetAttrPath(attr))
+}
+
+// artifactDigest is the `payload_sha256` of an artifact for field. The API
+// masks `$md.sensitive` values in the payloads it returns, so they are masked
+// the same way here and an imported artifact hashes like the configured one.
+func artifactDigest(pc *ProviderClient, field, schemaPath string, payload map[string]any) (string, error) {
+	if schemaPath == "" {
+		schemaPath = DEFAULT_ARTIFACT_SCHEMA_PATH
 	}
+	return maskedBundleDigest(field, schemaPath, pc.typeRefs, payload)
+}
 
-	// the schema-artifacts file has schemas for all of the artifacts in it (there can be more than one artifact).
-	// We unmarshal all the schemas and pull out just the schema for this artifact to perform validation
-	var schemaObj ArtifactSchema
-	err = json.Unmarshal(schemaBytes, &schemaObj)
+func setArtifactDigest(d *schema.ResourceData, pc *ProviderClient, payload map[string]any) error {
+	digest, err := artifactDigest(pc, d.Get("field").(string), d.Get("schema_path").(string), payload)
 	if err != nil {
 		return err
 	}
-	specificSchema, exists := schemaObj.Properties[field]
-	if !exists {
-		return errors.New(`artifact validation failed: field "` + field + `" does not exist in schema`)
-	}
+	return d.Set("payload_sha256", digest)
+}
 
-	// Validate
-	sl := gojsonschema.NewGoLoader(specificSchema.(map[string]interface{}))
-	dl := gojsonschema.NewStringLoader(artifact)
+// artifactPayload returns the configured artifact JSON and the attribute it
+// came from: `artifact_wo` when set, `artifact` otherwise.
+func artifactPayload(d *schema.ResourceData) (attr, artifactJSON string) {
+	if wo, ok, _ := writeOnlyValue(d.GetRawConfig(), "artifact_wo"); ok {
+		return "artifact_wo", wo
+	}
+	return "artifact", d.Get("artifact").(string)
+}
 
-	result, err := gojsonschema.Validate(sl, dl)
+// resourceArtifactCustomizeDiff plans an update when the configured artifact's
+// digest differs from `payload_sha256`, which is how a changed `artifact_wo`,
+// absent from the plan itself, reaches Update. An unchanged `artifact` is
+// left alone so state written before `payload_sha256` existed plans nothing.
+// Without the bundle's schema to mask the artifact, no digest can be
+// compared, and Create/Update report the missing schema when they validate.
+func resourceArtifactCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	attr, artifactJSON, known := "artifact", d.Get("artifact").(string), d.NewValueKnown("artifact")
+	if wo, ok, woKnown := writeOnlyValue(d.GetRawConfig(), "artifact_wo"); ok {
+		attr, artifactJSON, known = "artifact_wo", wo, woKnown
+	} else if d.Id() != "" && !d.HasChange("artifact") {
+		return nil
+	}
+	if !known || !d.NewValueKnown("field") || !d.NewValueKnown("schema_path") {
+		return d.SetNewComputed("payload_sha256")
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(artifactJSON), &payload); err != nil {
+		return fmt.Errorf("invalid JSON in `%s`: %w", attr, err)
+	}
+	digest, err := artifactDigest(meta.(*ProviderClient), d.Get("field").(string), d.Get("schema_path").(string), payload)
 	if err != nil {
-		return err
+		tflog.Warn(ctx, "unable to compare payload_sha256", map[string]any{"error": err.Error()})
+		return nil
 	}
-	if !result.Valid() {
-		return errors.New("artifact validation failed: " + result.Errors()[0].String())
+	if digest != d.Get("payload_sha256").(string) {
+		return d.SetNew("payload_sha256", digest)
 	}
-
 	return nil
 }
 
 // For now we need to fetch the type from the massdriver.yaml file
-func getArtifactType(d *schema.ResourceData, mdClient *client.Client) (string, error) {
+func getArtifactType(d *schema.ResourceData, mdClient *client.Client) (string, diag.Diagnostics) {
 	field := d.Get("field").(string)
 	specificationPath := d.Get("specification_path").(string)
 	if specificationPath == "" {
 		specificationPath = DEFAULT_SPECIFICATION_PATH
 	}
 
-	specificationBytes, err := os.ReadFile(specificationPath)
-	if err != nil {
-		return "", errors.New(`Unable to open specification file: ` + specificationPath)
-	}
-
-	var bundleSpec BundleSpecification
-	err = yaml.Unmarshal(specificationBytes, &bundleSpec)
-	if err != nil {
-		return "", err
+	artifactType, diags := bundleResourceRef(field, specificationPath)
+	if diags.HasError() {
+		return "", diags
 	}
 
-	artifactSpec, exists := bundleSpec.Artifacts.Properties[field]
-	if !exists {
-		return "", errors.New(`artifact validation failed: field "` + field + `" does not exist in specification`)
-	}
-
-	artifactType, exists := artifactSpec["$ref"]
-	if !exists {
-		return "", errors.New(`artifact validation failed: field "` + field + `" does not contain a $ref`)
-	}
-
-	split := strings.Split(artifactType, "/")
-	if len(split) != 2 {
-		artifactType = strings.Join([]string{mdClient.Config.OrganizationID, artifactType}, "/")
-	}
-
-	return artifactType, nil
+	return prefixOrgIfNeeded(artifactType, mdClient.Config.OrganizationID), diags
 }
 
-func generateArtifact(d *schema.ResourceData, mdClient *client.Client) (*artifacts.Artifact, error) {
+func generateArtifact(d *schema.ResourceData, mdClient *client.Client) (*artifacts.Artifact, diag.Diagnostics) {
 	artifact := artifacts.Artifact{}
 
-	artifactString := d.Get("artifact").(string)
+	_, artifactString := artifactPayload(d)
 	artifact.Field = d.Get("field").(string)
 	artifact.Name = d.Get("name").(string)
 
-	var typeErr error
-	artifact.Type, typeErr = getArtifactType(d, mdClient)
-	if typeErr != nil {
-		return nil, typeErr
+	var diags diag.Diagnostics
+	artifact.Type, diags = getArtifactType(d, mdClient)
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	// Unmarshal the user's artifact JSON into a map for the payload
 	var payload map[string]interface{}
 	unmarshalErr := json.Unmarshal([]byte(artifactString), &payload)
 	if unmarshalErr != nil {
-		return nil, unmarshalErr
+		return nil, append(diags, diag.FromErr(unmarshalErr)...)
 	}
 
 	// Set the payload field - this is the new format that the API expects
@@ -284,5 +388,5 @@ func generateArtifact(d *schema.ResourceData, mdClient *client.Client) (*artifac
 		artifact.Specs = specs.(map[string]interface{})
 	}
 
-	return &artifact, nil
+	return &artifact, diags
 }
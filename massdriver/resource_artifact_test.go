@@ -1,12 +1,17 @@
 package massdriver
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 )
 
 // All four CRUD contexts must remain wired — artifact stays functional in
@@ -34,6 +39,174 @@ func TestResourceArtifactAllContextsWired(t *testing.T) {
 	}
 }
 
+// Artifacts are imported through the resources endpoint. Only the payload's
+// digest is kept, so an `artifact_wo` config never puts it in state.
+func TestResourceArtifactImport(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "pkg-vpc",
+			"field":   "vpc",
+			"name":    "Production VPC",
+			"type":    testOrgID + "/aws-vpc",
+			"payload": map[string]any{"data": map[string]any{"id": "vpc-123"}, "specs": map[string]any{}},
+		})
+	})
+	_, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+	chdirIntoBundle(t, schemaPath)
+
+	rd := schema.TestResourceDataRaw(t, resourceArtifact().Schema, map[string]any{})
+	rd.SetId("pkg-vpc")
+
+	if _, err := resourceArtifact().Importer.StateContext(t.Context(), rd, pc); err != nil {
+		t.Fatal(err)
+	}
+	if (*reqs)[0].Path != "/v1/resources/pkg-vpc" {
+		t.Errorf("got path %s", (*reqs)[0].Path)
+	}
+	if rd.Get("field") != "vpc" || rd.Get("name") != "Production VPC" || rd.Get("resource_type") != testOrgID+"/aws-vpc" {
+		t.Errorf("got field=%v name=%v resource_type=%v", rd.Get("field"), rd.Get("name"), rd.Get("resource_type"))
+	}
+	if got := rd.Get("artifact").(string); got != "" {
+		t.Errorf("the payload must not be imported into artifact, got %s", got)
+	}
+	if got, want := rd.Get("payload_sha256"), sha256Hex(`{"data":{"id":"vpc-123"},"specs":{}}`); got != want {
+		t.Errorf("got payload_sha256 %v, want %s", got, want)
+	}
+	if rd.Get("schema_path") != DEFAULT_ARTIFACT_SCHEMA_PATH || rd.Get("specification_path") != DEFAULT_SPECIFICATION_PATH {
+		t.Errorf("path attributes should be set to their defaults, got %v / %v", rd.Get("schema_path"), rd.Get("specification_path"))
+	}
+}
+
+// An `artifact_wo` payload is sent but only the digest of its masked form
+// reaches state. A changed payload plans an update; a change to sensitive
+// values alone needs a new artifact_wo_version.
+func TestResourceArtifactWriteOnly(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "pkg-db", "field": "db", "name": "Database", "type": testOrgID + "/postgres"})
+	})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	server := newSDKServer(pc)
+
+	config := func(payload string) string {
+		b, err := json.Marshal(map[string]any{
+			"field":               "db",
+			"name":                "Database",
+			"artifact_wo":         payload,
+			"artifact_wo_version": "1",
+			"specification_path":  specPath,
+			"schema_path":         schemaPath,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	created, diags := applyResource(t, server, "massdriver_artifact", "", config(`{"data": {"username": "admin", "password": "correct-horse-battery"}}`))
+	requireNoDiagnostics(t, diags)
+	if strings.Contains(created, "correct-horse-battery") {
+		t.Fatalf("payload leaked into state: %s", created)
+	}
+	if want := sha256Hex(`{"data":{"password":"[SENSITIVE]","username":"admin"}}`); !strings.Contains(created, `"payload_sha256":"`+want+`"`) {
+		t.Errorf("got state %s, want payload_sha256 %s", created, want)
+	}
+	if post := (*reqs)[0]; post.Method != http.MethodPost || post.Path != "/v1/artifacts" {
+		t.Fatalf("got %s %s, want the create POST first", post.Method, post.Path)
+	}
+
+	planned, diags := planResource(t, server, "massdriver_artifact", created, config(`{"data":{"password":"correct-horse-battery","username":"admin"}}`))
+	requireNoDiagnostics(t, diags)
+	if planned != created {
+		t.Errorf("reformatting the payload should plan no changes:\n got %s\nwant %s", planned, created)
+	}
+
+	*reqs = nil
+	updated, diags := applyResource(t, server, "massdriver_artifact", created, config(`{"data":{"username":"root","password":"correct-horse-battery"}}`))
+	requireNoDiagnostics(t, diags)
+	if strings.Contains(updated, "correct-horse-battery") {
+		t.Fatalf("payload leaked into state: %s", updated)
+	}
+	if len(*reqs) == 0 || (*reqs)[0].Method != http.MethodPut {
+		t.Fatalf("a changed payload should update the artifact, got %v", *reqs)
+	}
+	if data, _ := (*reqs)[0].Body["payload"].(map[string]any)["data"].(map[string]any); data["username"] != "root" {
+		t.Errorf("got payload %v, want the new username", (*reqs)[0].Body["payload"])
+	}
+
+	rotated := config(`{"data":{"username":"root","password":"battery-staple-horse"}}`)
+	planned, diags = planResource(t, server, "massdriver_artifact", updated, rotated)
+	requireNoDiagnostics(t, diags)
+	if planned != updated {
+		t.Errorf("a masked change alone can't be seen and should plan nothing:\n got %s\nwant %s", planned, updated)
+	}
+	*reqs = nil
+	_, diags = applyResource(t, server, "massdriver_artifact", updated, strings.Replace(rotated, `"artifact_wo_version":"1"`, `"artifact_wo_version":"2"`, 1))
+	requireNoDiagnostics(t, diags)
+	if len(*reqs) == 0 || (*reqs)[0].Method != http.MethodPut {
+		t.Fatalf("a new artifact_wo_version should update the artifact, got %v", *reqs)
+	}
+	if data, _ := (*reqs)[0].Body["payload"].(map[string]any)["data"].(map[string]any); data["password"] != "battery-staple-horse" {
+		t.Errorf("got payload %v, want the new password", (*reqs)[0].Body["payload"])
+	}
+}
+
+// The API returns an artifact's sensitive values masked, so importing one
+// managed with `artifact_wo` and planning the same artifact changes nothing.
+func TestResourceArtifactImportWriteOnly(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "pkg-db",
+			"field":   "db",
+			"name":    "Database",
+			"type":    testOrgID + "/postgres",
+			"payload": map[string]any{"data": map[string]any{"username": "admin", "password": "[SENSITIVE]"}},
+		})
+	})
+	_, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	chdirIntoBundle(t, schemaPath)
+	server := newSDKServer(pc)
+
+	imported, diags := importResource(t, server, "massdriver_artifact", "pkg-db")
+	requireNoDiagnostics(t, diags)
+	refreshed, diags := readResource(t, server, "massdriver_artifact", imported)
+	requireNoDiagnostics(t, diags)
+
+	config, err := json.Marshal(map[string]any{
+		"field":       "db",
+		"name":        "Database",
+		"artifact_wo": `{"data":{"username":"admin","password":"correct-horse-battery"}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := planResourceChange(t, server, "massdriver_artifact", refreshed, string(config))
+	requireNoDiagnostics(t, plan.Diagnostics)
+	if planned := stateJSON(t, resourceValueType(t, server, "massdriver_artifact"), plan.PlannedState); planned != refreshed {
+		t.Errorf("the imported artifact should plan nothing:\n got %s\nwant %s", planned, refreshed)
+	}
+	for _, req := range *reqs {
+		if req.Method != http.MethodGet {
+			t.Errorf("import and plan should only read, got %s %s", req.Method, req.Path)
+		}
+	}
+}
+
+func TestResourceArtifactImportRequiresDeploymentAuth(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	pc.Client.Config.Credentials = &config.Credentials{Method: config.AuthAPIKey}
+
+	rd := schema.TestResourceDataRaw(t, resourceArtifact().Schema, map[string]any{})
+	rd.SetId("pkg-vpc")
+
+	_, err := resourceArtifact().Importer.StateContext(t.Context(), rd, pc)
+	if err == nil || !strings.Contains(err.Error(), "massdriver_artifact") {
+		t.Fatalf("expected a deployment-credentials error, got %v", err)
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("no request should be sent without deployment credentials, got %d", len(*reqs))
+	}
+}
+
 func TestAccMassdriverArtifactBasic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -78,3 +251,137 @@ func testAccCheckMassdriverArtifactExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// massdriver_artifact and massdriver_resource read the bundle files through
+// the same loader, so a broken bundle is reported the same way by both.
+func TestResourceArtifactBundleErrorsMatchResource(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	specPath, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+	_, otherSchemaPath := writeBundleFiles(t, "database", "aws-rds", objectSchema())
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name       string
+		field      string
+		schemaPath string
+		specPath   string
+	}{
+		{name: "missing schema file", field: "vpc", schemaPath: missing, specPath: specPath},
+		{name: "field not in schema", field: "vpc", schemaPath: otherSchemaPath, specPath: specPath},
+		{name: "field not in spec", field: "database", schemaPath: otherSchemaPath, specPath: specPath},
+		{name: "missing spec file", field: "vpc", schemaPath: schemaPath, specPath: missing},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact := schema.TestResourceDataRaw(t, resourceArtifact().Schema, map[string]any{
+				"field":              tc.field,
+				"name":               "My VPC",
+				"artifact":           `{}`,
+				"schema_path":        tc.schemaPath,
+				"specification_path": tc.specPath,
+			})
+			resource := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{
+				"field":              tc.field,
+				"name":               "My VPC",
+				"resource":           `{}`,
+				"schema_path":        tc.schemaPath,
+				"specification_path": tc.specPath,
+			})
+
+			artifactDiags := resourceArtifactCreate(t.Context(), artifact, pc)
+			resourceDiags := resourceResourceCreate(t.Context(), resource, pc)
+			if !artifactDiags.HasError() || !resourceDiags.HasError() {
+				t.Fatalf("expected both to fail, got %v / %v", artifactDiags, resourceDiags)
+			}
+			if artifactDiags[0].Summary != resourceDiags[0].Summary {
+				t.Errorf("artifact reported %q, resource reported %q", artifactDiags[0].Summary, resourceDiags[0].Summary)
+			}
+		})
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("a broken bundle must not reach the server, got %d requests", len(*reqs))
+	}
+}
+
+func TestResourceArtifactRead(t *testing.T) {
+	const legacyID = "2023-04-05T06:07:08Z"
+
+	tests := []struct {
+		name        string
+		id          string
+		packageName string
+		status      int
+		wantPath    string
+		wantID      string
+		wantRefresh bool
+	}{
+		{name: "refreshes", id: "pkg-vpc", status: http.StatusOK, wantPath: "/v1/resources/pkg-vpc", wantID: "pkg-vpc", wantRefresh: true},
+		{name: "gone", id: "pkg-vpc", status: http.StatusNotFound, wantPath: "/v1/resources/pkg-vpc"},
+		// The legacy ID stays in state; only the lookup uses the translated one.
+		{name: "legacy timestamp ID", id: legacyID, packageName: "proj-env-net-0000", status: http.StatusOK, wantPath: "/v1/resources/proj-env-net-0000-vpc", wantID: legacyID, wantRefresh: true},
+		{name: "legacy timestamp ID without a package name", id: legacyID, wantID: legacyID},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("MASSDRIVER_PACKAGE_NAME", tc.packageName)
+			pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				if tc.status != http.StatusOK {
+					w.WriteHeader(tc.status)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"id":    "pkg-vpc",
+					"field": "network",
+					"name":  "Renamed VPC",
+					"type":  testOrgID + "/aws-vpc",
+				})
+			})
+
+			rd := schema.TestResourceDataRaw(t, resourceArtifact().Schema, map[string]any{
+				"field":    "vpc",
+				"name":     "My VPC",
+				"artifact": `{"data":{}}`,
+			})
+			rd.SetId(tc.id)
+
+			if diags := resourceArtifact().ReadContext(t.Context(), rd, pc); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if tc.wantPath == "" {
+				if len(*reqs) != 0 {
+					t.Errorf("expected no lookup, got %s %s", (*reqs)[0].Method, (*reqs)[0].Path)
+				}
+			} else if len(*reqs) != 1 || (*reqs)[0].Method != http.MethodGet || (*reqs)[0].Path != tc.wantPath {
+				t.Errorf("got requests %v, want GET %s", *reqs, tc.wantPath)
+			}
+			if rd.Id() != tc.wantID {
+				t.Errorf("got id %q, want %q", rd.Id(), tc.wantID)
+			}
+			if !tc.wantRefresh {
+				return
+			}
+			if rd.Get("field") != "network" || rd.Get("name") != "Renamed VPC" || rd.Get("resource_type") != testOrgID+"/aws-vpc" {
+				t.Errorf("got field=%v name=%v resource_type=%v", rd.Get("field"), rd.Get("name"), rd.Get("resource_type"))
+			}
+		})
+	}
+}
+
+// Outside a deployment the artifact can't be read; refresh keeps the state
+// and says so instead of failing the plan.
+func TestResourceArtifactReadWithoutDeploymentAuth(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	pc.Client.Config.Credentials = &config.Credentials{Method: config.AuthAPIKey}
+
+	rd := schema.TestResourceDataRaw(t, resourceArtifact().Schema, map[string]any{"field": "vpc", "name": "My VPC", "artifact": `{}`})
+	rd.SetId("pkg-vpc")
+
+	diags := resourceArtifact().ReadContext(t.Context(), rd, pc)
+	if diags.HasError() || len(diags) != 1 || !strings.Contains(diags[0].Summary, "not refreshed") {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if rd.Id() != "pkg-vpc" || len(*reqs) != 0 {
+		t.Errorf("state should be kept without a lookup, got id %q and %d requests", rd.Id(), len(*reqs))
+	}
+}
@@ -0,0 +1,216 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceComponent() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a component in a project's blueprint: a slot for a bundle that is deployed once per environment. Import with `<project_id>/<identifier>`.",
+
+		CreateContext: resourceComponentCreate,
+		ReadContext:   resourceComponentRead,
+		UpdateContext: resourceComponentUpdate,
+		DeleteContext: resourceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComponentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "ID of the project whose blueprint the component belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"identifier": {
+				Description:  "Short identifier for the component (max 20 characters, lowercase alphanumeric), e.g. `db`. Becomes the final segment of every instance identifier (`ecomm-prod-db`). The resource `id` is the full component ID (`ecomm-db`). Immutable.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(identifierPattern, "must be 1-20 lowercase alphanumeric characters"),
+			},
+			"oci_repo": {
+				Description: "Name of the bundle's OCI repository (e.g. `aws-aurora-postgres`). Immutable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "Human-readable name for the component.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Free-text description of the component's purpose.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attributes": {
+				Description: "Key-value attributes assigned to the component. Must conform to the organization's custom attributes for the `COMPONENT` scope.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"position": {
+				Description: "Position of the component on the blueprint canvas. When omitted, the position is left to the UI and any changes made there are not reported as drift.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Description: "Horizontal offset in pixels.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"y": {
+							Description: "Vertical offset in pixels.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	component, err := api.AddComponent(ctx, client, d.Get("project_id").(string), d.Get("oci_repo").(string), api.AddComponentInput{
+		Id:          d.Get("identifier").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Attributes:  d.Get("attributes").(map[string]any),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(component.ID)
+
+	if x, y, ok := componentPosition(d); ok {
+		if err := api.SetComponentPosition(ctx, client, d.Id(), x, y); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceComponentRead(ctx, d, meta)
+}
+
+func resourceComponentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	component, err := api.GetComponent(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project_id", component.Project.ID)
+	d.Set("identifier", strings.TrimPrefix(component.ID, component.Project.ID+"-"))
+	d.Set("oci_repo", component.OciRepo.Name)
+	d.Set("name", component.Name)
+	d.Set("description", component.Description)
+	if err := d.Set("attributes", component.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	var position []any
+	if component.Position != nil {
+		position = []any{map[string]any{"x": component.Position.X, "y": component.Position.Y}}
+	}
+	if err := d.Set("position", position); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if d.HasChanges("name", "description", "attributes") {
+		input := api.UpdateComponentInput{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Attributes:  d.Get("attributes").(map[string]any),
+		}
+		if _, err := api.UpdateComponent(ctx, client, d.Id(), input); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("position") {
+		if x, y, ok := componentPosition(d); ok {
+			if err := api.SetComponentPosition(ctx, client, d.Id(), x, y); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceComponentRead(ctx, d, meta)
+}
+
+// resourceComponentDelete reports deployed instances up front, the same way
+// projects and environments do, rather than letting removeComponent fail.
+func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	component, err := api.GetComponent(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := component.Deletable.Err("component " + d.Id() + " cannot be deleted"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := api.RemoveComponent(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceComponentImport accepts `<project_id>/<identifier>` and maps it to
+// the API's `<project_id>-<identifier>` component ID.
+func resourceComponentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	projectID, identifier, ok := strings.Cut(d.Id(), "/")
+	if !ok || projectID == "" || identifier == "" {
+		return nil, fmt.Errorf("invalid import ID %q: expected <project_id>/<identifier>", d.Id())
+	}
+
+	d.SetId(projectID + "-" + identifier)
+	d.Set("project_id", projectID)
+	d.Set("identifier", identifier)
+	return []*schema.ResourceData{d}, nil
+}
+
+// componentPosition returns the configured canvas position, if any.
+func componentPosition(d *schema.ResourceData) (int, int, bool) {
+	position, ok := d.Get("position").([]any)
+	if !ok || len(position) == 0 || position[0] == nil {
+		return 0, 0, false
+	}
+	p := position[0].(map[string]any)
+	return p["x"].(int), p["y"].(int), true
+}
@@ -0,0 +1,139 @@
+package massdriver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func componentReadResponse(position map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"component": map[string]any{
+				"id":          "ecomm-db",
+				"name":        "Database",
+				"description": "Orders database",
+				"attributes":  map[string]any{},
+				"position":    position,
+				"ociRepo":     map[string]any{"name": "aws-aurora-postgres"},
+				"project":     map[string]any{"id": "ecomm"},
+				"deletable":   map[string]any{"result": true, "constraints": []any{}},
+			},
+		},
+	}
+}
+
+func TestResourceComponentCreateSetsPosition(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"addComponent": {
+			"data": map[string]any{
+				"addComponent": map[string]any{
+					"result":     map[string]any{"id": "ecomm-db", "name": "Database"},
+					"successful": true,
+				},
+			},
+		},
+		"setComponentPosition": {
+			"data": map[string]any{
+				"setComponentPosition": map[string]any{
+					"result":     map[string]any{"id": "ecomm-db"},
+					"successful": true,
+				},
+			},
+		},
+		"getComponent": componentReadResponse(map[string]any{"x": 100, "y": 200}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceComponent().Schema, map[string]any{
+		"project_id":  "ecomm",
+		"identifier":  "db",
+		"oci_repo":    "aws-aurora-postgres",
+		"name":        "Database",
+		"description": "Orders database",
+		"position":    []any{map[string]any{"x": 100, "y": 200}},
+	})
+
+	if diags := resourceComponentCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "ecomm-db" {
+		t.Errorf("got id %q, want ecomm-db", rd.Id())
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("addComponent"))
+	if vars["projectId"] != "ecomm" || vars["ociRepoName"] != "aws-aurora-postgres" {
+		t.Errorf("got variables %v", vars)
+	}
+	pos := gqlmock.Variables(rec.FindRequest("setComponentPosition"))["input"].(map[string]any)
+	if pos["x"] != float64(100) || pos["y"] != float64(200) {
+		t.Errorf("got position input %v", pos)
+	}
+	if rd.Get("identifier") != "db" {
+		t.Errorf("got identifier %v, want db", rd.Get("identifier"))
+	}
+}
+
+func TestResourceComponentCreateWithoutPosition(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"addComponent": {
+			"data": map[string]any{
+				"addComponent": map[string]any{
+					"result":     map[string]any{"id": "ecomm-db", "name": "Database"},
+					"successful": true,
+				},
+			},
+		},
+		"getComponent": componentReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceComponent().Schema, map[string]any{
+		"project_id": "ecomm",
+		"identifier": "db",
+		"oci_repo":   "aws-aurora-postgres",
+		"name":       "Database",
+	})
+
+	if diags := resourceComponentCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rec.FindRequest("setComponentPosition") != nil {
+		t.Error("setComponentPosition must not fire without a position block")
+	}
+}
+
+func TestResourceComponentImport(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, resourceComponent().Schema, map[string]any{})
+	rd.SetId("ecomm/db")
+
+	out, err := resourceComponentImport(t.Context(), rd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Id() != "ecomm-db" {
+		t.Errorf("got id %q, want ecomm-db", out[0].Id())
+	}
+	if out[0].Get("project_id") != "ecomm" || out[0].Get("identifier") != "db" {
+		t.Errorf("got project_id=%v identifier=%v", out[0].Get("project_id"), out[0].Get("identifier"))
+	}
+
+	for _, bad := range []string{"ecomm-db", "ecomm/", "/db"} {
+		rd := schema.TestResourceDataRaw(t, resourceComponent().Schema, map[string]any{})
+		rd.SetId(bad)
+		if _, err := resourceComponentImport(t.Context(), rd, nil); err == nil {
+			t.Errorf("import ID %q should be rejected", bad)
+		}
+	}
+}
+
+func TestResourceComponentSchema(t *testing.T) {
+	r := resourceComponent()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema invalid: %v", err)
+	}
+	for _, attr := range []string{"project_id", "identifier", "oci_repo"} {
+		if !r.Schema[attr].ForceNew {
+			t.Errorf("%s should be ForceNew", attr)
+		}
+	}
+}
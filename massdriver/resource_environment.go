@@ -0,0 +1,243 @@
+package massdriver
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a Massdriver environment within a project. Environments can be created empty in a project or forked from an existing environment. Destroying an environment that still has provisioned instances fails with the list of blocking resources.",
+
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Description:  "Short identifier for the environment (max 20 characters, lowercase alphanumeric), e.g. `prod`. Becomes the second segment of every instance identifier in the environment (`ecomm-prod-db`). The resource `id` is the full environment ID returned by Massdriver (`ecomm-prod`). Immutable.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(identifierPattern, "must be 1-20 lowercase alphanumeric characters"),
+			},
+			"project_id": {
+				Description:  "ID of the project to create the environment in. Exactly one of `project_id` and `fork_from` must be set; when forking, this is computed from the parent environment.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"project_id", "fork_from"},
+			},
+			"fork_from": {
+				Description: "ID of an existing environment to fork. The new environment is created in the parent's project and starts with a copy of the parent's instance configuration. Changing this forces a new environment.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"copy_environment_defaults": {
+				Description:  "When forking, also copy the parent's environment default resources into the new environment. Requires `fork_from`.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"fork_from"},
+			},
+			"name": {
+				Description: "Human-readable name for the environment.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Free-text description of the environment's purpose.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attributes": {
+				Description: "Key-value attributes assigned to the environment. Must conform to the organization's custom attributes for the `ENVIRONMENT` scope.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"effective_attributes": {
+				Description: "Attributes in effect for the environment after merging those inherited from the project with the environment's own `attributes`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cost": {
+				Description: "Cloud spend reported for the environment. Amounts are `0` until billing data is available.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_month": {
+							Description: "Total cost for the previous calendar month.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"monthly_average": {
+							Description: "Average monthly cost.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"last_day": {
+							Description: "Total cost for the previous day.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"daily_average": {
+							Description: "Average daily cost.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"currency": {
+							Description: "ISO 4217 currency code the amounts are reported in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	identifier := d.Get("identifier").(string)
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	attributes := d.Get("attributes").(map[string]any)
+
+	var env *api.Environment
+	var err error
+	if parent, ok := d.GetOk("fork_from"); ok {
+		env, err = api.ForkEnvironment(ctx, client, parent.(string), api.ForkEnvironmentInput{
+			Id:                      identifier,
+			Name:                    name,
+			Description:             description,
+			Attributes:              attributes,
+			CopyEnvironmentDefaults: d.Get("copy_environment_defaults").(bool),
+		})
+	} else {
+		env, err = api.CreateEnvironment(ctx, client, d.Get("project_id").(string), api.CreateEnvironmentInput{
+			Id:          identifier,
+			Name:        name,
+			Description: description,
+			Attributes:  attributes,
+		})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(env.ID)
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	env, err := api.GetEnvironment(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// The API only returns the full `project-env` ID, so the short identifier
+	// is recovered by stripping the project prefix (needed after import).
+	d.Set("identifier", strings.TrimPrefix(env.ID, env.Project.ID+"-"))
+	d.Set("project_id", env.Project.ID)
+	d.Set("name", env.Name)
+	d.Set("description", env.Description)
+	if err := d.Set("attributes", env.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("effective_attributes", env.EffectiveAttributes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cost", flattenCost(env.Cost)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	input := api.UpdateEnvironmentInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Attributes:  d.Get("attributes").(map[string]any),
+	}
+	if _, err := api.UpdateEnvironment(ctx, client, d.Id(), input); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+// resourceEnvironmentDelete mirrors the project resource: blocking
+// constraints (provisioned instances, forks) are reported up front instead
+// of attempting a deleteEnvironment that will fail.
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	env, err := api.GetEnvironment(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := env.Deletable.Err("environment " + d.Id() + " cannot be deleted"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := api.DeleteEnvironment(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// flattenCost converts a cost summary to the single-element `cost` block.
+// Samples without billing data come back with no currency, so the currency
+// is taken from whichever sample has one.
+func flattenCost(cost api.CostSummary) []any {
+	currency := ""
+	for _, sample := range []api.CostSample{cost.LastMonth, cost.MonthlyAverage, cost.LastDay, cost.DailyAverage} {
+		if sample.Currency != "" {
+			currency = sample.Currency
+			break
+		}
+	}
+	return []any{map[string]any{
+		"last_month":      cost.LastMonth.Amount,
+		"monthly_average": cost.MonthlyAverage.Amount,
+		"last_day":        cost.LastDay.Amount,
+		"daily_average":   cost.DailyAverage.Amount,
+		"currency":        currency,
+	}}
+}
@@ -0,0 +1,193 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEnvironmentDefault() *schema.Resource {
+	return &schema.Resource{
+		Description: "Pins a resource as the environment default for its resource type. Instances in the environment that need a resource of that type are connected to it automatically. An environment can have only one default per resource type; a conflicting default is reported at plan time. Import with `<environment_id>/<resource_id>`.",
+
+		CreateContext: resourceEnvironmentDefaultCreate,
+		ReadContext:   resourceEnvironmentDefaultRead,
+		UpdateContext: resourceEnvironmentDefaultUpdate,
+		DeleteContext: resourceEnvironmentDefaultDelete,
+		CustomizeDiff: resourceEnvironmentDefaultCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentDefaultImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Description: "ID of the environment (e.g. `ecomm-prod`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"resource_id": {
+				Description: "ID of the resource to use as the default for its type. Changing it swaps the default in place.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_type": {
+				Description: "Resource type the default applies to (e.g. `aws-vpc`).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentDefaultCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	def, err := api.SetEnvironmentDefault(ctx, client, d.Get("environment_id").(string), d.Get("resource_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(def.ID)
+	return resourceEnvironmentDefaultRead(ctx, d, meta)
+}
+
+// resourceEnvironmentDefaultRead drops the default from state when it has
+// been removed or replaced outside Terraform, so the next plan re-pins it.
+func resourceEnvironmentDefaultRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	defaults, err := api.ListEnvironmentDefaults(ctx, client, d.Get("environment_id").(string))
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for _, def := range defaults {
+		if def.ID == d.Id() {
+			d.Set("resource_id", def.Resource.ID)
+			d.Set("resource_type", def.Resource.ResourceType.ID)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceEnvironmentDefaultUpdate swaps the pinned resource. The server has
+// no update mutation, so the old default is removed before the new one is
+// set (a type can't have two defaults at once), and the ID changes.
+//
+// resource_id is deliberately not ForceNew: a replacement would run
+// CustomizeDiff a second time without prior state, and the conflict check
+// would then see this resource's own default as someone else's.
+func resourceEnvironmentDefaultUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if _, err := api.RemoveEnvironmentDefault(ctx, client, d.Id()); err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	def, err := api.SetEnvironmentDefault(ctx, client, d.Get("environment_id").(string), d.Get("resource_id").(string))
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	d.SetId(def.ID)
+	return resourceEnvironmentDefaultRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDefaultDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if _, err := api.RemoveEnvironmentDefault(ctx, client, d.Id()); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceEnvironmentDefaultImport accepts `<environment_id>/<resource_id>`
+// and resolves it to the default's own ID.
+func resourceEnvironmentDefaultImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderClient).Client
+
+	environmentID, resourceID, ok := strings.Cut(d.Id(), "/")
+	if !ok || environmentID == "" || resourceID == "" {
+		return nil, fmt.Errorf("invalid import ID %q: expected <environment_id>/<resource_id>", d.Id())
+	}
+
+	defaults, err := api.ListEnvironmentDefaults(ctx, client, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defaults {
+		if def.Resource.ID == resourceID {
+			d.SetId(def.ID)
+			d.Set("environment_id", environmentID)
+			d.Set("resource_id", resourceID)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %s is not a default in environment %s", resourceID, environmentID)
+}
+
+// resourceEnvironmentDefaultCustomizeDiff turns the server's "only one
+// default per resource type" rule into a plan-time error. The default this
+// resource already owns is ignored, since Update removes it before setting
+// the new one. Unknown IDs (e.g. a resource created in the same
+// apply) can't be checked until apply.
+func resourceEnvironmentDefaultCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChanges("environment_id", "resource_id") {
+		return nil
+	}
+	environmentID, resourceID := d.Get("environment_id").(string), d.Get("resource_id").(string)
+	if !d.NewValueKnown("environment_id") || !d.NewValueKnown("resource_id") || environmentID == "" || resourceID == "" {
+		return nil
+	}
+	client := meta.(*ProviderClient).Client
+
+	resource, err := api.GetResource(ctx, client, resourceID)
+	if err != nil {
+		return err
+	}
+	resourceType := resource.ResourceType.ID
+	if err := d.SetNew("resource_type", resourceType); err != nil {
+		return err
+	}
+
+	defaults, err := api.ListEnvironmentDefaults(ctx, client, environmentID)
+	if err != nil {
+		// A brand new environment won't exist yet at plan time.
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	for _, def := range defaults {
+		if def.ID == d.Id() || def.Resource.ResourceType.ID != resourceType {
+			continue
+		}
+		if def.Resource.ID == resourceID {
+			return fmt.Errorf("resource %s is already the %s default in environment %s; import it with `terraform import <address> %s/%s`", resourceID, resourceType, environmentID, environmentID, resourceID)
+		}
+		return fmt.Errorf("environment %s already has a default for resource type %s (resource %s %q); an environment can have only one default per resource type, so remove that default first", environmentID, resourceType, def.Resource.ID, def.Resource.Name)
+	}
+	return nil
+}
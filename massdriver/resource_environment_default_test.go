@@ -0,0 +1,193 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+const testDefaultID = "44444444-4444-4444-4444-444444444444"
+
+// environmentDefaultsResponse builds a getEnvironmentDefaults page. Each item
+// is {defaultID, resourceID, resourceTypeID}.
+func environmentDefaultsResponse(items ...[3]string) map[string]any {
+	list := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		list = append(list, map[string]any{
+			"id": item[0],
+			"resource": map[string]any{
+				"id":           item[1],
+				"name":         item[1] + "-name",
+				"resourceType": map[string]any{"id": item[2]},
+			},
+		})
+	}
+	return map[string]any{
+		"data": map[string]any{
+			"environment": map[string]any{
+				"id": "ecomm-prod",
+				"defaults": map[string]any{
+					"cursor": map[string]any{"next": nil},
+					"items":  list,
+				},
+			},
+		},
+	}
+}
+
+func getResourceResponse(resourceID, resourceType string) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"resource": map[string]any{
+				"id":           resourceID,
+				"resourceType": map[string]any{"id": resourceType},
+			},
+		},
+	}
+}
+
+func TestResourceEnvironmentDefaultCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"setEnvironmentDefault": {
+			"data": map[string]any{
+				"setEnvironmentDefault": map[string]any{
+					"result":     map[string]any{"id": testDefaultID, "resource": map[string]any{"id": "vpc-1"}},
+					"successful": true,
+				},
+			},
+		},
+		"getEnvironmentDefaults": environmentDefaultsResponse([3]string{testDefaultID, "vpc-1", "aws-vpc"}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironmentDefault().Schema, map[string]any{
+		"environment_id": "ecomm-prod",
+		"resource_id":    "vpc-1",
+	})
+
+	if diags := resourceEnvironmentDefaultCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != testDefaultID {
+		t.Errorf("got id %q, want %s", rd.Id(), testDefaultID)
+	}
+	vars := gqlmock.Variables(rec.FindRequest("setEnvironmentDefault"))
+	if vars["environmentId"] != "ecomm-prod" || vars["resourceId"] != "vpc-1" {
+		t.Errorf("got variables %v", vars)
+	}
+	if rd.Get("resource_type") != "aws-vpc" {
+		t.Errorf("got resource_type %v, want aws-vpc", rd.Get("resource_type"))
+	}
+}
+
+// A default removed (or swapped for another resource) outside Terraform no
+// longer appears under its ID, so it must drop out of state.
+func TestResourceEnvironmentDefaultReadDetectsDrift(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getEnvironmentDefaults": environmentDefaultsResponse([3]string{"other-default", "vpc-2", "aws-vpc"}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironmentDefault().Schema, map[string]any{
+		"environment_id": "ecomm-prod",
+		"resource_id":    "vpc-1",
+	})
+	rd.SetId(testDefaultID)
+
+	if diags := resourceEnvironmentDefaultRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when the default is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceEnvironmentDefaultImport(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getEnvironmentDefaults": environmentDefaultsResponse([3]string{testDefaultID, "vpc-1", "aws-vpc"}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironmentDefault().Schema, map[string]any{})
+	rd.SetId("ecomm-prod/vpc-1")
+
+	out, err := resourceEnvironmentDefaultImport(t.Context(), rd, pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Id() != testDefaultID || out[0].Get("environment_id") != "ecomm-prod" {
+		t.Errorf("got id=%q environment_id=%v", out[0].Id(), out[0].Get("environment_id"))
+	}
+}
+
+func TestResourceEnvironmentDefaultPlanConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    *terraform.InstanceState
+		defaults [][3]string
+		wantErr  string
+	}{
+		{
+			name:     "no existing default",
+			defaults: [][3]string{{"dns-default", "zone-1", "aws-route53-zone"}},
+		},
+		{
+			name:     "another resource holds the type",
+			defaults: [][3]string{{"other-default", "vpc-2", "aws-vpc"}},
+			wantErr:  "only one default per resource type",
+		},
+		{
+			name:     "same resource already default",
+			defaults: [][3]string{{"other-default", "vpc-1", "aws-vpc"}},
+			wantErr:  "terraform import",
+		},
+		{
+			// Swapping this resource's own default to a new resource is
+			// fine: Update removes the old default before setting the new one.
+			name: "own default is replaced",
+			state: &terraform.InstanceState{
+				ID: testDefaultID,
+				Attributes: map[string]string{
+					"id":             testDefaultID,
+					"environment_id": "ecomm-prod",
+					"resource_id":    "vpc-2",
+					"resource_type":  "aws-vpc",
+				},
+			},
+			defaults: [][3]string{{testDefaultID, "vpc-2", "aws-vpc"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pc, _ := newMockProvider(map[string]map[string]any{
+				"getResource":            getResourceResponse("vpc-1", "aws-vpc"),
+				"getEnvironmentDefaults": environmentDefaultsResponse(tc.defaults...),
+			})
+			cfg := terraform.NewResourceConfigRaw(map[string]any{
+				"environment_id": "ecomm-prod",
+				"resource_id":    "vpc-1",
+			})
+
+			diff, err := resourceEnvironmentDefault().Diff(t.Context(), tc.state, cfg, pc)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if tc.state != nil {
+					if diff.RequiresNew() {
+						t.Error("changing resource_id should update in place")
+					}
+					return
+				}
+				if got := diff.Attributes["resource_type"].New; got != "aws-vpc" {
+					t.Errorf("resource_type should be known at plan, got %q", got)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+// environmentReadResponse is a canned getEnvironment response used after
+// Create/Update.
+func environmentReadResponse(deletable map[string]any) map[string]any {
+	if deletable == nil {
+		deletable = map[string]any{"result": true, "constraints": []any{}}
+	}
+	return map[string]any{
+		"data": map[string]any{
+			"environment": map[string]any{
+				"id":                  "ecomm-prod",
+				"name":                "Production",
+				"attributes":          map[string]any{"tier": "gold"},
+				"effectiveAttributes": map[string]any{"tier": "gold", "team": "payments"},
+				"project":             map[string]any{"id": "ecomm"},
+				"cost": map[string]any{
+					"lastMonth":      map[string]any{"amount": nil, "currency": nil},
+					"monthlyAverage": map[string]any{"amount": 398.25, "currency": "USD"},
+					"lastDay":        map[string]any{"amount": nil, "currency": nil},
+					"dailyAverage":   map[string]any{"amount": 13.1, "currency": "USD"},
+				},
+				"deletable": deletable,
+			},
+		},
+	}
+}
+
+func TestResourceEnvironmentCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"createEnvironment": {
+			"data": map[string]any{
+				"createEnvironment": map[string]any{
+					"result":     map[string]any{"id": "ecomm-prod", "name": "Production"},
+					"successful": true,
+				},
+			},
+		},
+		"getEnvironment": environmentReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{
+		"identifier": "prod",
+		"project_id": "ecomm",
+		"name":       "Production",
+		"attributes": map[string]any{"tier": "gold"},
+	})
+
+	if diags := resourceEnvironmentCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "ecomm-prod" {
+		t.Errorf("got id %q, want ecomm-prod", rd.Id())
+	}
+	if rec.FindRequest("forkEnvironment") != nil {
+		t.Error("forkEnvironment must not fire without fork_from")
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("createEnvironment"))
+	if vars["projectId"] != "ecomm" {
+		t.Errorf("got projectId %v, want ecomm", vars["projectId"])
+	}
+	if input := vars["input"].(map[string]any); input["id"] != "prod" {
+		t.Errorf("got input %v", input)
+	}
+
+	if rd.Get("identifier") != "prod" {
+		t.Errorf("got identifier %v, want prod", rd.Get("identifier"))
+	}
+	if rd.Get("effective_attributes.team") != "payments" {
+		t.Errorf("got effective_attributes %v", rd.Get("effective_attributes"))
+	}
+	if rd.Get("cost.0.last_month") != 0.0 || rd.Get("cost.0.monthly_average") != 398.25 {
+		t.Errorf("got cost %v", rd.Get("cost"))
+	}
+	// lastMonth has no billing data yet; currency still comes from a
+	// populated sample.
+	if rd.Get("cost.0.currency") != "USD" {
+		t.Errorf("got currency %v, want USD", rd.Get("cost.0.currency"))
+	}
+}
+
+func TestResourceEnvironmentCreateForks(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"forkEnvironment": {
+			"data": map[string]any{
+				"forkEnvironment": map[string]any{
+					"result":     map[string]any{"id": "ecomm-prod", "name": "Production"},
+					"successful": true,
+				},
+			},
+		},
+		"getEnvironment": environmentReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{
+		"identifier":                "prod",
+		"fork_from":                 "ecomm-staging",
+		"copy_environment_defaults": true,
+		"name":                      "Production",
+	})
+
+	if diags := resourceEnvironmentCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rec.FindRequest("createEnvironment") != nil {
+		t.Error("createEnvironment must not fire when fork_from is set")
+	}
+	vars := gqlmock.Variables(rec.FindRequest("forkEnvironment"))
+	if vars["parentId"] != "ecomm-staging" {
+		t.Errorf("got parentId %v, want ecomm-staging", vars["parentId"])
+	}
+	if input := vars["input"].(map[string]any); input["copyEnvironmentDefaults"] != true {
+		t.Errorf("expected copyEnvironmentDefaults true, got %v", input)
+	}
+	// project_id is computed from the parent when forking.
+	if rd.Get("project_id") != "ecomm" {
+		t.Errorf("got project_id %v, want ecomm", rd.Get("project_id"))
+	}
+}
+
+func TestResourceEnvironmentCreateReportsValidationMessages(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"createEnvironment": {
+			"data": map[string]any{
+				"createEnvironment": map[string]any{
+					"successful": false,
+					"messages": []map[string]any{
+						{"field": "id", "message": "has already been taken"},
+						{"field": "name", "message": "can't be blank"},
+					},
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{
+		"identifier": "prod",
+		"project_id": "ecomm",
+		"name":       "Production",
+	})
+
+	diags := resourceEnvironmentCreate(t.Context(), rd, pc)
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+	for _, want := range []string{"unable to create environment", "has already been taken", "can't be blank"} {
+		if !strings.Contains(diags[0].Summary, want) {
+			t.Errorf("error %q should mention %q", diags[0].Summary, want)
+		}
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should not be set on failure, got %q", rd.Id())
+	}
+}
+
+func TestResourceEnvironmentReadClearsWhenMissing(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getEnvironment": {"data": map[string]any{"environment": nil}},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{})
+	rd.SetId("ecomm-gone")
+
+	if diags := resourceEnvironmentRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when the environment is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceEnvironmentDeleteReportsConstraints(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getEnvironment": environmentReadResponse(map[string]any{
+			"result": false,
+			"constraints": []map[string]any{
+				{"message": "Instance is provisioned", "type": "instance", "id": "ecomm-prod-db"},
+			},
+		}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{})
+	rd.SetId("ecomm-prod")
+
+	diags := resourceEnvironmentDelete(t.Context(), rd, pc)
+	if !diags.HasError() {
+		t.Fatal("expected deletion-constraint error, got none")
+	}
+	if !strings.Contains(diags[0].Summary, "ecomm-prod-db") {
+		t.Errorf("error %q should name the blocking instance", diags[0].Summary)
+	}
+	if rec.FindRequest("deleteEnvironment") != nil {
+		t.Error("deleteEnvironment must not fire while constraints block deletion")
+	}
+}
+
+func TestResourceEnvironmentDelete(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getEnvironment": environmentReadResponse(nil),
+		"deleteEnvironment": {
+			"data": map[string]any{
+				"deleteEnvironment": map[string]any{
+					"result":     map[string]any{"id": "ecomm-prod"},
+					"successful": true,
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceEnvironment().Schema, map[string]any{})
+	rd.SetId("ecomm-prod")
+
+	if diags := resourceEnvironmentDelete(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared after delete, got %q", rd.Id())
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("deleteEnvironment")); vars["id"] != "ecomm-prod" {
+		t.Errorf("got id %v, want ecomm-prod", vars["id"])
+	}
+}
+
+func TestResourceEnvironmentSchema(t *testing.T) {
+	r := resourceEnvironment()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema invalid: %v", err)
+	}
+	if r.Importer == nil {
+		t.Error("massdriver_environment should be importable")
+	}
+	for _, attr := range []string{"identifier", "project_id", "fork_from", "copy_environment_defaults"} {
+		if !r.Schema[attr].ForceNew {
+			t.Errorf("%s should be ForceNew", attr)
+		}
+	}
+}
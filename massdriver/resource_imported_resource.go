@@ -0,0 +1,159 @@
+package massdriver
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceImportedResource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Registers pre-existing infrastructure (cloud credentials, shared networks, ...) as an `IMPORTED` Massdriver resource. Unlike `massdriver_resource`, this works from any Terraform configuration with API key or access token credentials. The payload is validated against the resource type's schema before it is sent.",
+
+		CreateContext: resourceImportedResourceCreate,
+		ReadContext:   resourceImportedResourceRead,
+		UpdateContext: resourceImportedResourceUpdate,
+		DeleteContext: resourceImportedResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_type_id": {
+				Description: "ID of the resource type the payload conforms to (e.g. `aws-iam-role`). Changing this forces a new resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "Human-readable name for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"payload": {
+				Description:      "JSON-encoded resource data, typically built with `jsonencode`. Must conform to the resource type's schema. Massdriver masks sensitive fields when the resource is read, so the payload is never refreshed from the API; after `terraform import` the next apply writes the configured payload.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"attributes": {
+				Description: "Key-value attributes assigned to the resource. Read-only: the API does not yet allow setting attributes on imported resources.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceImportedResourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	pc := meta.(*ProviderClient)
+	client := pc.Client
+
+	resourceTypeID := d.Get("resource_type_id").(string)
+	payload, diags := validatedImportedPayload(ctx, pc, resourceTypeID, d.Get("payload").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	resource, err := api.CreateResource(ctx, client, resourceTypeID, api.CreateResourceInput{
+		Name:    d.Get("name").(string),
+		Payload: payload,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.ID)
+	return resourceImportedResourceRead(ctx, d, meta)
+}
+
+func resourceImportedResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	resource, err := api.GetResource(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", resource.Name)
+	d.Set("resource_type_id", resource.ResourceType.ID)
+	if err := d.Set("attributes", resource.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceImportedResourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	pc := meta.(*ProviderClient)
+	client := pc.Client
+
+	input := api.UpdateResourceInput{
+		Name: d.Get("name").(string),
+	}
+	if d.HasChange("payload") {
+		payload, diags := validatedImportedPayload(ctx, pc, d.Get("resource_type_id").(string), d.Get("payload").(string))
+		if diags.HasError() {
+			return diags
+		}
+		input.Payload = payload
+	}
+
+	if _, err := api.UpdateResource(ctx, client, d.Id(), input); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceImportedResourceRead(ctx, d, meta)
+}
+
+func resourceImportedResourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if _, err := api.DeleteResource(ctx, client, d.Id()); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// validatedImportedPayload checks the payload against the resource type's
+// schema as published in Massdriver, so mistakes are reported against the
+// same rules the server applies but before anything is written.
+func validatedImportedPayload(ctx context.Context, pc *ProviderClient, resourceTypeID, payloadJSON string) (map[string]any, diag.Diagnostics) {
+	typeSchema, err := pc.ResourceTypeSchema(ctx, resourceTypeID)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if len(typeSchema) > 0 {
+		if diags := validateAgainstSchema("resource validation failed", typeSchema, payloadJSON, cty.GetAttrPath("payload")); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(payloadJSON), &payload); err != nil {
+		return nil, diag.Errorf("invalid JSON in `payload`: %s", err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,178 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+const testImportedResourceID = "66666666-6666-6666-6666-666666666666"
+
+// iamRoleTypeResponse is a getResourceType response with a schema requiring
+// `data.arn`.
+var iamRoleTypeResponse = map[string]any{
+	"data": map[string]any{
+		"resourceType": map[string]any{
+			"id":   "aws-iam-role",
+			"name": "AWS IAM Role",
+			"schema": map[string]any{
+				"type":     "object",
+				"required": []any{"data"},
+				"properties": map[string]any{
+					"data": map[string]any{
+						"type":     "object",
+						"required": []any{"arn"},
+						"properties": map[string]any{
+							"arn": map[string]any{"type": "string"},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var importedResourceReadResponse = map[string]any{
+	"data": map[string]any{
+		"resource": map[string]any{
+			"id":           testImportedResourceID,
+			"name":         "CI role",
+			"origin":       "IMPORTED",
+			"attributes":   map[string]any{"team": "platform"},
+			"resourceType": map[string]any{"id": "aws-iam-role"},
+		},
+	},
+}
+
+func TestResourceImportedResourceCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getResourceType": iamRoleTypeResponse,
+		"createResource": {
+			"data": map[string]any{
+				"createResource": map[string]any{
+					"result":     map[string]any{"id": testImportedResourceID, "name": "CI role"},
+					"successful": true,
+				},
+			},
+		},
+		"getResource": importedResourceReadResponse,
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceImportedResource().Schema, map[string]any{
+		"resource_type_id": "aws-iam-role",
+		"name":             "CI role",
+		"payload":          `{"data":{"arn":"arn:aws:iam::123:role/ci"}}`,
+	})
+
+	if diags := resourceImportedResourceCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != testImportedResourceID {
+		t.Errorf("got id %q, want %s", rd.Id(), testImportedResourceID)
+	}
+	if rd.Get("attributes.team") != "platform" {
+		t.Errorf("got attributes %v", rd.Get("attributes"))
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("createResource"))
+	if vars["resourceTypeId"] != "aws-iam-role" {
+		t.Errorf("got resourceTypeId %v", vars["resourceTypeId"])
+	}
+	input := vars["input"].(map[string]any)
+	if input["name"] != "CI role" {
+		t.Errorf("got input %v", input)
+	}
+	if input["payload"] == nil {
+		t.Error("payload should be sent")
+	}
+}
+
+// A payload that doesn't match the remote schema must fail before anything
+// is written.
+func TestResourceImportedResourceCreateValidatesPayload(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getResourceType": iamRoleTypeResponse,
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceImportedResource().Schema, map[string]any{
+		"resource_type_id": "aws-iam-role",
+		"name":             "CI role",
+		"payload":          `{"data":{}}`,
+	})
+
+	diags := resourceImportedResourceCreate(t.Context(), rd, pc)
+	if !diags.HasError() {
+		t.Fatal("expected validation error, got none")
+	}
+	if !strings.Contains(diags[0].Summary, "resource validation failed") || !strings.Contains(diags[0].Summary, "arn") {
+		t.Errorf("got %q, want a validation error naming arn", diags[0].Summary)
+	}
+	if rec.FindRequest("createResource") != nil {
+		t.Error("createResource must not fire when validation fails")
+	}
+}
+
+func TestResourceImportedResourceUpdate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getResourceType": iamRoleTypeResponse,
+		"updateResource": {
+			"data": map[string]any{
+				"updateResource": map[string]any{
+					"result":     map[string]any{"id": testImportedResourceID, "name": "CI role"},
+					"successful": true,
+				},
+			},
+		},
+		"getResource": importedResourceReadResponse,
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceImportedResource().Schema, map[string]any{
+		"resource_type_id": "aws-iam-role",
+		"name":             "CI role",
+		"payload":          `{"data":{"arn":"arn:aws:iam::123:role/ci"}}`,
+	})
+	rd.SetId(testImportedResourceID)
+
+	if diags := resourceImportedResourceUpdate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	input := gqlmock.Variables(rec.FindRequest("updateResource"))["input"].(map[string]any)
+	if input["name"] != "CI role" {
+		t.Errorf("got input %v", input)
+	}
+	// Map scalars go over the wire JSON-encoded as a string.
+	if payload, _ := input["payload"].(string); !strings.Contains(payload, "arn:aws:iam::123:role/ci") {
+		t.Errorf("got payload %v", input["payload"])
+	}
+}
+
+func TestResourceImportedResourceReadClearsWhenMissing(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getResource": {"data": map[string]any{"resource": nil}},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceImportedResource().Schema, map[string]any{})
+	rd.SetId(testImportedResourceID)
+
+	if diags := resourceImportedResourceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when the resource is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceImportedResourceSchema(t *testing.T) {
+	r := resourceImportedResource()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema invalid: %v", err)
+	}
+	if !r.Schema["payload"].Sensitive {
+		t.Error("payload must be sensitive")
+	}
+	if !r.Schema["resource_type_id"].ForceNew {
+		t.Error("resource_type_id should be ForceNew")
+	}
+}
@@ -3,94 +3,136 @@ package massdriver
 import (
 	"context"
 	"os"
+	"sort"
 	"strings"
 
 	"terraform-provider-massdriver/internal/api"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceInstanceAlarm() *schema.Resource {
-	return &schema.Resource{
-		Description: "Registers a cloud metric alarm with a Massdriver instance. State updates arrive via webhooks from CloudWatch / Azure Monitor / GCP Cloud Monitoring / Alertmanager. Replaces the v0 `massdriver_package_alarm`.",
+// instanceAlarmResource is `massdriver_instance_alarm`, the first resource
+// served by the plugin framework. Its attributes are the ones the SDKv2
+// implementation had; state it wrote (schema version 0) is upgraded once to
+// hold null where SDKv2 stored zero values for unset fields.
+type instanceAlarmResource struct {
+	pc *ProviderClient
+}
 
-		CreateContext: resourceInstanceAlarmCreate,
-		ReadContext:   resourceInstanceAlarmRead,
-		UpdateContext: resourceInstanceAlarmUpdate,
-		DeleteContext: resourceInstanceAlarmDelete,
+var (
+	_ resource.ResourceWithConfigure    = (*instanceAlarmResource)(nil)
+	_ resource.ResourceWithImportState  = (*instanceAlarmResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*instanceAlarmResource)(nil)
+)
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+func newInstanceAlarmResource() resource.Resource {
+	return &instanceAlarmResource{}
+}
+
+type instanceAlarmModel struct {
+	ID                 types.String       `tfsdk:"id"`
+	InstanceID         types.String       `tfsdk:"instance_id"`
+	DisplayName        types.String       `tfsdk:"display_name"`
+	CloudResourceID    types.String       `tfsdk:"cloud_resource_id"`
+	ComparisonOperator types.String       `tfsdk:"comparison_operator"`
+	Threshold          types.Float64      `tfsdk:"threshold"`
+	Period             types.Int64        `tfsdk:"period"`
+	Metric             []alarmMetricModel `tfsdk:"metric"`
+}
 
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
+type alarmMetricModel struct {
+	Namespace  types.String `tfsdk:"namespace"`
+	Name       types.String `tfsdk:"name"`
+	Statistic  types.String `tfsdk:"statistic"`
+	Region     types.String `tfsdk:"region"`
+	Dimensions types.Map    `tfsdk:"dimensions"`
+}
+
+func (r *instanceAlarmResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_alarm"
+}
+
+func (r *instanceAlarmResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     1,
+		Description: "Registers a cloud metric alarm with a Massdriver instance. State updates arrive via webhooks from CloudWatch / Azure Monitor / GCP Cloud Monitoring / Alertmanager. Replaces the v0 `massdriver_package_alarm`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
 				Description: "ID of the instance this alarm is attached to. Defaults to the environment variable `MASSDRIVER_INSTANCE_ID` if set, which is the case in a Massdriver deployment. Must be set explicitly when running outside a Massdriver deployment. Immutable after creation.",
-				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
-				DefaultFunc: instanceIDFromEnv,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					instanceIDDefault{},
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"display_name": {
+			"display_name": schema.StringAttribute{
 				Description: "Human-readable name shown in the Massdriver UI and notifications.",
-				Type:        schema.TypeString,
 				Required:    true,
 			},
-			"cloud_resource_id": {
+			"cloud_resource_id": schema.StringAttribute{
 				Description: "Cloud provider's unique identifier for the alarm (e.g., a CloudWatch AlarmArn). Used to correlate inbound webhooks back to this alarm. Must be unique within the instance.",
-				Type:        schema.TypeString,
 				Required:    true,
 			},
-			"comparison_operator": {
+			"comparison_operator": schema.StringAttribute{
 				Description: "How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`). This is displayed in the Massdriver UI for informational purposes only.",
-				Type:        schema.TypeString,
 				Optional:    true,
 			},
-			"threshold": {
+			"threshold": schema.Float64Attribute{
 				Description: "Value crossed to trigger the alarm. This is displayed in the Massdriver UI for informational purposes only.",
-				Type:        schema.TypeFloat,
 				Optional:    true,
 			},
-			"period": {
+			"period": schema.Int64Attribute{
 				Description: "Evaluation window in seconds over which the metric is aggregated. This is displayed in the Massdriver UI for informational purposes only.",
-				Type:        schema.TypeInt,
 				Optional:    true,
 			},
-			"metric": {
+		},
+
+		Blocks: map[string]schema.Block{
+			"metric": schema.ListNestedBlock{
 				Description: "Cloud metric the alarm evaluates. This is displayed in the Massdriver UI for informational purposes only.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"namespace": {
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace": schema.StringAttribute{
 							Description: "Cloud service namespace (e.g., `AWS/RDS`). This is displayed in the Massdriver UI for informational purposes only.",
-							Type:        schema.TypeString,
 							Optional:    true,
 						},
-						"name": {
+						"name": schema.StringAttribute{
 							Description: "Metric name within the namespace (e.g., `CPUUtilization`). This is displayed in the Massdriver UI for informational purposes only.",
-							Type:        schema.TypeString,
 							Optional:    true,
 						},
-						"statistic": {
+						"statistic": schema.StringAttribute{
 							Description: "Aggregation function (e.g., `Average`). Empty for providers without it. This is displayed in the Massdriver UI for informational purposes only.",
-							Type:        schema.TypeString,
 							Optional:    true,
 						},
-						"region": {
+						"region": schema.StringAttribute{
 							Description: "Cloud region the metric is scoped to, when applicable. This is displayed in the Massdriver UI for informational purposes only.",
-							Type:        schema.TypeString,
 							Optional:    true,
 						},
-						"dimensions": {
+						"dimensions": schema.MapAttribute{
 							Description: "Key-value dimensions identifying the monitored resource. Empty when the provider doesn't expose structured dimensions. This is displayed in the Massdriver UI for informational purposes only.",
-							Type:        schema.TypeMap,
+							ElementType: types.StringType,
 							Optional:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
 						},
 					},
 				},
@@ -99,169 +141,340 @@ func resourceInstanceAlarm() *schema.Resource {
 	}
 }
 
-func resourceInstanceAlarmCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*ProviderClient).Client
+// UpgradeState upgrades state the SDKv2 implementation wrote. SDKv2 stored
+// `""` and `0` for optional fields left out of the configuration, which the
+// framework would plan to change to null on every run. The upgrade can't see
+// the configuration, so an explicitly configured zero becomes null too and
+// is sent again by one in-place update.
+func (r *instanceAlarmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	prior := current.Schema
+	prior.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state instanceAlarmModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				state.nullSDKv2Zeros()
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
+		},
+	}
+}
+
+func (r *instanceAlarmResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	pc, diags := providerClient(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.pc = pc
+}
+
+func (r *instanceAlarmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan instanceAlarmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	instanceID := d.Get("instance_id").(string)
+	instanceID := plan.InstanceID.ValueString()
 	if instanceID == "" {
-		return diag.Errorf("instance_id must be set in config, or MASSDRIVER_INSTANCE_ID / MASSDRIVER_PACKAGE_NAME must be set in the environment")
+		resp.Diagnostics.AddAttributeError(path.Root("instance_id"),
+			"instance_id must be set in config, or MASSDRIVER_INSTANCE_ID / MASSDRIVER_PACKAGE_NAME must be set in the environment", "")
+		return
 	}
 
 	input := api.CreateInstanceAlarmInput{
-		CloudResourceId: d.Get("cloud_resource_id").(string),
-		DisplayName:     d.Get("display_name").(string),
+		CloudResourceId:    plan.CloudResourceID.ValueString(),
+		DisplayName:        plan.DisplayName.ValueString(),
+		ComparisonOperator: plan.ComparisonOperator.ValueString(),
+		Threshold:          plan.threshold(),
+		Period:             plan.period(),
+		Metric:             plan.metricInput(),
 	}
-	if v, ok := d.GetOk("comparison_operator"); ok {
-		input.ComparisonOperator = v.(string)
-	}
-	if v, ok := d.GetOk("threshold"); ok {
-		f := v.(float64)
-		input.Threshold = &f
+
+	alarm, err := api.CreateInstanceAlarm(ctx, r.pc.Client, instanceID, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create instance alarm", err.Error())
+		return
 	}
-	if v, ok := d.GetOk("period"); ok {
-		p := v.(int)
-		input.Period = &p
+
+	plan.ID = types.StringValue(alarm.ID)
+	// Save the ID before reading back so a failed read doesn't orphan the
+	// alarm that was just created.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	r.readInto(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	input.Metric = parseAlarmMetric(d.Get("metric").([]any))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	alarm, err := api.CreateInstanceAlarm(ctx, client, instanceID, input)
-	if err != nil {
-		return diag.FromErr(err)
+func (r *instanceAlarmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceAlarmModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.SetId(alarm.ID)
-	return resourceInstanceAlarmRead(ctx, d, meta)
+	r.readInto(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// instanceIDFromEnv is the DefaultFunc for `instance_id`. MASSDRIVER_INSTANCE_ID
-// wins if set (use case: caller already knows the canonical instance ID).
-// Otherwise it falls back to MASSDRIVER_PACKAGE_NAME — the env var bundle
-// deployments inject — and strips the trailing deployment suffix (e.g.
-// `bundtst-plygrnd-awsaurorapos-rbpt` → `bundtst-plygrnd-awsaurorapos`).
-// Returns nil when neither is set so the user can resolve it explicitly via
-// HCL when running outside a deployment; Create surfaces a clear error if it
-// stays empty.
-func instanceIDFromEnv() (any, error) {
-	if id := os.Getenv("MASSDRIVER_INSTANCE_ID"); id != "" {
-		return id, nil
+func (r *instanceAlarmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan instanceAlarmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	name := os.Getenv("MASSDRIVER_PACKAGE_NAME")
-	if name == "" {
-		return nil, nil
+
+	input := api.UpdateInstanceAlarmInput{
+		CloudResourceId:    plan.CloudResourceID.ValueString(),
+		DisplayName:        plan.DisplayName.ValueString(),
+		ComparisonOperator: plan.ComparisonOperator.ValueString(),
+		Threshold:          plan.threshold(),
+		Period:             plan.period(),
+		Metric:             plan.metricInput(),
+	}
+
+	if _, err := api.UpdateInstanceAlarm(ctx, r.pc.Client, plan.ID.ValueString(), input); err != nil {
+		resp.Diagnostics.AddError("Unable to update instance alarm", err.Error())
+		return
 	}
-	parts := strings.Split(name, "-")
-	if len(parts) < 2 {
-		return name, nil
+
+	r.readInto(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return strings.Join(parts[:len(parts)-1], "-"), nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceInstanceAlarmRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*ProviderClient).Client
+func (r *instanceAlarmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state instanceAlarmModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	alarm, err := api.GetInstanceAlarm(ctx, client, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if _, err := api.DeleteInstanceAlarm(ctx, r.pc.Client, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to delete instance alarm", err.Error())
 	}
+}
 
-	d.Set("display_name", alarm.DisplayName)
-	d.Set("cloud_resource_id", alarm.CloudResourceID)
-	d.Set("comparison_operator", alarm.ComparisonOperator)
-	d.Set("threshold", alarm.Threshold)
-	d.Set("period", alarm.Period)
+func (r *instanceAlarmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	if alarm.Metric == nil {
-		d.Set("metric", nil)
-	} else {
-		metric := map[string]any{
-			"namespace":  alarm.Metric.Namespace,
-			"name":       alarm.Metric.Name,
-			"statistic":  alarm.Metric.Statistic,
-			"region":     alarm.Metric.Region,
-			"dimensions": dimensionsToMap(alarm.Metric.Dimensions),
-		}
-		if err := d.Set("metric", []any{metric}); err != nil {
-			return diag.FromErr(err)
-		}
+// readInto fetches the alarm m.ID names and copies it into m.
+func (r *instanceAlarmResource) readInto(ctx context.Context, m *instanceAlarmModel, diags *diag.Diagnostics) {
+	alarm, err := api.GetInstanceAlarm(ctx, r.pc.Client, m.ID.ValueString())
+	if err != nil {
+		diags.AddError("Unable to read instance alarm", err.Error())
+		return
 	}
-
-	return nil
+	m.refresh(alarm)
 }
 
-func resourceInstanceAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*ProviderClient).Client
+// refresh copies alarm into m. The API reports an optional field that was
+// never set as its zero value. Where m holds null for such a field — it was
+// left out of the configuration — the null is kept, so the next plan doesn't
+// propose changing `threshold` from 0 back to null. Anything else is
+// overwritten.
+func (m *instanceAlarmModel) refresh(alarm *api.InstanceAlarm) {
+	m.DisplayName = types.StringValue(alarm.DisplayName)
+	m.CloudResourceID = types.StringValue(alarm.CloudResourceID)
+	m.ComparisonOperator = refreshString(m.ComparisonOperator, alarm.ComparisonOperator)
+	m.Threshold = refreshFloat64(m.Threshold, alarm.Threshold)
+	m.Period = refreshInt64(m.Period, int64(alarm.Period))
 
-	input := api.UpdateInstanceAlarmInput{
-		CloudResourceId:    d.Get("cloud_resource_id").(string),
-		DisplayName:        d.Get("display_name").(string),
-		ComparisonOperator: d.Get("comparison_operator").(string),
+	if alarm.Metric == nil {
+		// An empty list rather than null: that's what a configuration
+		// without the block decodes to.
+		m.Metric = []alarmMetricModel{}
+		return
 	}
-	if v, ok := d.GetOk("threshold"); ok {
-		f := v.(float64)
-		input.Threshold = &f
+	var prior alarmMetricModel
+	if len(m.Metric) > 0 {
+		prior = m.Metric[0]
 	}
-	if v, ok := d.GetOk("period"); ok {
-		p := v.(int)
-		input.Period = &p
+	dimensions := prior.Dimensions
+	if len(alarm.Metric.Dimensions) > 0 || !prior.Dimensions.IsNull() {
+		dimensions = types.MapValueMust(types.StringType, dimensionsToMap(alarm.Metric.Dimensions))
 	}
-	input.Metric = parseAlarmMetric(d.Get("metric").([]any))
+	m.Metric = []alarmMetricModel{{
+		Namespace:  refreshString(prior.Namespace, alarm.Metric.Namespace),
+		Name:       refreshString(prior.Name, alarm.Metric.Name),
+		Statistic:  refreshString(prior.Statistic, alarm.Metric.Statistic),
+		Region:     refreshString(prior.Region, alarm.Metric.Region),
+		Dimensions: dimensions,
+	}}
+}
 
-	if _, err := api.UpdateInstanceAlarm(ctx, client, d.Id(), input); err != nil {
-		return diag.FromErr(err)
+// nullSDKv2Zeros replaces the zero values SDKv2 stored for unset optional
+// fields, including those in the metric block, with null.
+func (m *instanceAlarmModel) nullSDKv2Zeros() {
+	m.ComparisonOperator = nullIfZero(m.ComparisonOperator)
+	if m.Threshold.ValueFloat64() == 0 {
+		m.Threshold = types.Float64Null()
+	}
+	if m.Period.ValueInt64() == 0 {
+		m.Period = types.Int64Null()
+	}
+	for i := range m.Metric {
+		metric := &m.Metric[i]
+		metric.Namespace = nullIfZero(metric.Namespace)
+		metric.Name = nullIfZero(metric.Name)
+		metric.Statistic = nullIfZero(metric.Statistic)
+		metric.Region = nullIfZero(metric.Region)
+		if len(metric.Dimensions.Elements()) == 0 {
+			metric.Dimensions = types.MapNull(types.StringType)
+		}
 	}
-
-	return resourceInstanceAlarmRead(ctx, d, meta)
 }
 
-func resourceInstanceAlarmDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*ProviderClient).Client
-
-	if _, err := api.DeleteInstanceAlarm(ctx, client, d.Id()); err != nil {
-		return diag.FromErr(err)
+func nullIfZero(v types.String) types.String {
+	if v.ValueString() == "" {
+		return types.StringNull()
 	}
+	return v
+}
 
-	d.SetId("")
-	return nil
+func (m *instanceAlarmModel) threshold() *float64 {
+	if m.Threshold.IsNull() || m.Threshold.IsUnknown() {
+		return nil
+	}
+	f := m.Threshold.ValueFloat64()
+	return &f
 }
 
-// parseAlarmMetric converts the optional metric block from terraform's nested-list
-// representation into the API input. Returns nil when the block is omitted, which
-// makes the field disappear from the JSON body via `omitempty`.
-func parseAlarmMetric(block []any) *api.AlarmMetricInput {
-	if len(block) == 0 || block[0] == nil {
+func (m *instanceAlarmModel) period() *int {
+	if m.Period.IsNull() || m.Period.IsUnknown() {
 		return nil
 	}
-	raw, ok := block[0].(map[string]any)
-	if !ok {
+	p := int(m.Period.ValueInt64())
+	return &p
+}
+
+// metricInput converts the optional metric block into the API input. It
+// returns nil when the block is omitted, which drops the field from the
+// request body via `omitempty`.
+func (m *instanceAlarmModel) metricInput() *api.AlarmMetricInput {
+	if len(m.Metric) == 0 {
 		return nil
 	}
+	block := m.Metric[0]
 	metric := &api.AlarmMetricInput{
-		Namespace: stringFrom(raw, "namespace"),
-		Name:      stringFrom(raw, "name"),
-		Statistic: stringFrom(raw, "statistic"),
-		Region:    stringFrom(raw, "region"),
-	}
-	if dims, ok := raw["dimensions"].(map[string]any); ok {
-		for k, v := range dims {
-			s, _ := v.(string)
-			metric.Dimensions = append(metric.Dimensions, api.AlarmMetricDimensionInput{
-				Name:  k,
-				Value: s,
-			})
-		}
+		Namespace: block.Namespace.ValueString(),
+		Name:      block.Name.ValueString(),
+		Statistic: block.Statistic.ValueString(),
+		Region:    block.Region.ValueString(),
+	}
+	dims := block.Dimensions.Elements()
+	names := make([]string, 0, len(dims))
+	for name := range dims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, _ := dims[name].(types.String)
+		metric.Dimensions = append(metric.Dimensions, api.AlarmMetricDimensionInput{
+			Name:  name,
+			Value: value.ValueString(),
+		})
 	}
 	return metric
 }
 
-func stringFrom(m map[string]any, key string) string {
-	v, _ := m[key].(string)
-	return v
+func refreshString(prior types.String, v string) types.String {
+	if v == "" && prior.IsNull() {
+		return prior
+	}
+	return types.StringValue(v)
+}
+
+func refreshFloat64(prior types.Float64, v float64) types.Float64 {
+	if v == 0 && prior.IsNull() {
+		return prior
+	}
+	return types.Float64Value(v)
+}
+
+func refreshInt64(prior types.Int64, v int64) types.Int64 {
+	if v == 0 && prior.IsNull() {
+		return prior
+	}
+	return types.Int64Value(v)
 }
 
-func dimensionsToMap(dims []api.AlarmMetricDimension) map[string]string {
-	out := make(map[string]string, len(dims))
+func dimensionsToMap(dims []api.AlarmMetricDimension) map[string]attr.Value {
+	out := make(map[string]attr.Value, len(dims))
 	for _, d := range dims {
-		out[d.Name] = d.Value
+		out[d.Name] = types.StringValue(d.Value)
 	}
 	return out
 }
+
+// instanceIDDefault plans `instance_id` from the environment when the
+// configuration leaves it out, the way an SDKv2 DefaultFunc would. With
+// nothing in the environment either, an existing alarm keeps the ID it was
+// created with and a new one plans null, which Create rejects.
+type instanceIDDefault struct{}
+
+func (instanceIDDefault) Description(context.Context) string {
+	return "Defaults to MASSDRIVER_INSTANCE_ID, or to MASSDRIVER_PACKAGE_NAME without its deployment suffix."
+}
+
+func (d instanceIDDefault) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+func (instanceIDDefault) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	if id, _ := instanceIDFromEnv(); id != nil {
+		resp.PlanValue = types.StringValue(id.(string))
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// instanceIDFromEnv resolves the default instance ID. MASSDRIVER_INSTANCE_ID
+// wins if set (use case: caller already knows the canonical instance ID).
+// Otherwise it falls back to MASSDRIVER_PACKAGE_NAME — the env var bundle
+// deployments inject — and strips the trailing deployment suffix (e.g.
+// `bundtst-plygrnd-awsaurorapos-rbpt` → `bundtst-plygrnd-awsaurorapos`).
+// Returns nil when neither is set so the user can resolve it explicitly via
+// HCL when running outside a deployment; Create surfaces a clear error if it
+// stays empty. Its signature is a schema.SchemaDefaultFunc, so SDKv2 schemas
+// can use it directly.
+func instanceIDFromEnv() (any, error) {
+	if id := os.Getenv("MASSDRIVER_INSTANCE_ID"); id != "" {
+		return id, nil
+	}
+	name := os.Getenv("MASSDRIVER_PACKAGE_NAME")
+	if name == "" {
+		return nil, nil
+	}
+	id, _ := instanceIDFromPackageName(name)
+	return id, nil
+}
+
+// instanceIDFromPackageName strips the deployment suffix — the last
+// hyphen-separated segment — from a package name. ok is false when the name
+// has no hyphen, in which case it is returned unchanged. The
+// instance_id_from_package_name function exposes it to HCL.
+func instanceIDFromPackageName(name string) (id string, ok bool) {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return name, false
+	}
+	return name[:i], true
+}
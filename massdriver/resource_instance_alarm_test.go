@@ -4,10 +4,13 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"terraform-provider-massdriver/internal/gqlmock"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
+const instanceAlarmType = "massdriver_instance_alarm"
+
 // alarmReadResponse is a canned getInstanceAlarm response used after Create/Update.
 func alarmReadResponse(extra map[string]any) map[string]any {
 	base := map[string]any{
@@ -35,49 +38,184 @@ func alarmReadResponse(extra map[string]any) map[string]any {
 	}
 }
 
-func TestResourceInstanceAlarmCreate(t *testing.T) {
-	pc, rec := newMockProvider(map[string]map[string]any{
-		"createInstanceAlarm": {
-			"data": map[string]any{
-				"createInstanceAlarm": map[string]any{
-					"result": map[string]any{
-						"id":              "alarm-1",
-						"displayName":     "RDS High CPU",
-						"cloudResourceId": "arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu",
-					},
-					"successful": true,
-				},
+// unsetAlarmReadResponse is an alarm with none of the optional fields, as
+// Alertmanager registers them.
+var unsetAlarmReadResponse = map[string]any{
+	"data": map[string]any{
+		"instanceAlarm": map[string]any{
+			"id":              "alarm-1",
+			"displayName":     "Alertmanager Page",
+			"cloudResourceId": "alertmanager:my-alert",
+		},
+	},
+}
+
+func createAlarmResponse(id string) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"createInstanceAlarm": map[string]any{
+				"result":     map[string]any{"id": id},
+				"successful": true,
 			},
 		},
-		"getInstanceAlarm": alarmReadResponse(nil),
-	})
+	}
+}
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		"instance_id":         "ecomm-prod-db",
-		"display_name":        "RDS High CPU",
-		"cloud_resource_id":   "arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu",
-		"comparison_operator": "GREATER_THAN",
-		"threshold":           80.0,
-		"period":              300,
-		"metric": []any{
-			map[string]any{
-				"namespace": "AWS/RDS",
-				"name":      "CPUUtilization",
-				"statistic": "Average",
-				"region":    "us-east-1",
-				"dimensions": map[string]any{
-					"DBInstanceIdentifier": "prod-db",
-				},
+// alarmConfig configures every attribute to match alarmReadResponse.
+const alarmConfig = `{
+	"instance_id": "ecomm-prod-db",
+	"display_name": "RDS High CPU",
+	"cloud_resource_id": "arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu",
+	"comparison_operator": "GREATER_THAN",
+	"threshold": 80,
+	"period": 300,
+	"metric": [{
+		"namespace": "AWS/RDS",
+		"name": "CPUUtilization",
+		"statistic": "Average",
+		"region": "us-east-1",
+		"dimensions": {"DBInstanceIdentifier": "prod-db"}
+	}]
+}`
+
+// State the SDKv2 implementation (v1.3.0) wrote, captured by driving it
+// through the same protocol calls these tests make: sdkv2AlarmState after
+// creating alarmConfig and after refreshing alarmReadResponse, and
+// sdkv2UnsetAlarmState after refreshing unsetAlarmReadResponse, where the
+// SDK stored zero values for the fields the API left out.
+//
+// To recapture them, check out the last revision with the SDKv2
+// resource_instance_alarm.go, run TestResourceInstanceAlarmStateMatchesSDKv2
+// there against newSDKServer(pc) instead of newFrameworkServer(t, pc), and
+// copy the states it produces.
+const (
+	sdkv2AlarmState      = `{"cloud_resource_id":"arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu","comparison_operator":"GREATER_THAN","display_name":"RDS High CPU","id":"alarm-1","instance_id":"ecomm-prod-db","metric":[{"dimensions":{"DBInstanceIdentifier":"prod-db"},"name":"CPUUtilization","namespace":"AWS/RDS","region":"us-east-1","statistic":"Average"}],"period":300,"threshold":80}`
+	sdkv2UnsetAlarmState = `{"cloud_resource_id":"alertmanager:my-alert","comparison_operator":"","display_name":"Alertmanager Page","id":"alarm-1","instance_id":"ecomm-prod-db","metric":[],"period":0,"threshold":0}`
+)
+
+// upgradedUnsetAlarmState is sdkv2UnsetAlarmState after the upgrade nulls the
+// zero values SDKv2 stored for the unset fields.
+const upgradedUnsetAlarmState = `{"cloud_resource_id":"alertmanager:my-alert","comparison_operator":null,"display_name":"Alertmanager Page","id":"alarm-1","instance_id":"ecomm-prod-db","metric":[],"period":null,"threshold":null}`
+
+// Moving the resource to the plugin framework must not change set values in
+// existing state: upgrading, refreshing and re-creating it produce what the
+// SDKv2 implementation did, except that the zeros it stored for unset fields
+// become null.
+func TestResourceInstanceAlarmStateMatchesSDKv2(t *testing.T) {
+	tests := []struct {
+		name     string
+		response map[string]any
+		run      func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic)
+		want     string
+	}{
+		{
+			name:     "create",
+			response: alarmReadResponse(nil),
+			run: func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic) {
+				return applyResource(t, server, instanceAlarmType, "", alarmConfig)
+			},
+			want: sdkv2AlarmState,
+		},
+		{
+			name:     "refresh",
+			response: alarmReadResponse(nil),
+			run: func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic) {
+				return readResource(t, server, instanceAlarmType, sdkv2AlarmState)
 			},
+			want: sdkv2AlarmState,
 		},
+		{
+			name:     "refresh with unset optional fields",
+			response: unsetAlarmReadResponse,
+			run: func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic) {
+				return readResource(t, server, instanceAlarmType, upgradedUnsetAlarmState)
+			},
+			want: upgradedUnsetAlarmState,
+		},
+		{
+			name: "upgrade",
+			run: func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic) {
+				return upgradeResourceState(t, server, sdkv2AlarmState)
+			},
+			want: sdkv2AlarmState,
+		},
+		{
+			name: "upgrade with unset optional fields",
+			run: func(t *testing.T, server tfprotov5.ProviderServer) (string, []*tfprotov5.Diagnostic) {
+				return upgradeResourceState(t, server, sdkv2UnsetAlarmState)
+			},
+			want: upgradedUnsetAlarmState,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pc, _ := newMockProvider(map[string]map[string]any{
+				"createInstanceAlarm": createAlarmResponse("alarm-1"),
+				"getInstanceAlarm":    tc.response,
+			})
+			got, diags := tc.run(t, newFrameworkServer(t, pc))
+			requireNoDiagnostics(t, diags)
+			if got != tc.want {
+				t.Errorf("state differs from SDKv2\n got: %s\nwant: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// After upgrading from SDKv2, an alarm whose configuration leaves the
+// optional fields out plans no changes.
+func TestResourceInstanceAlarmPlanAfterSDKv2Upgrade(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getInstanceAlarm": unsetAlarmReadResponse,
 	})
+	server := newFrameworkServer(t, pc)
 
-	if diags := resourceInstanceAlarmCreate(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
+	upgraded, diags := upgradeResourceState(t, server, sdkv2UnsetAlarmState)
+	requireNoDiagnostics(t, diags)
+	refreshed, diags := readResource(t, server, instanceAlarmType, upgraded)
+	requireNoDiagnostics(t, diags)
+
+	config := `{
+		"instance_id": "ecomm-prod-db",
+		"display_name": "Alertmanager Page",
+		"cloud_resource_id": "alertmanager:my-alert",
+		"metric": []
+	}`
+	planned, diags := planResource(t, server, instanceAlarmType, refreshed, config)
+	requireNoDiagnostics(t, diags)
+	if planned != refreshed {
+		t.Errorf("the upgraded alarm should plan no changes:\n got %s\nwant %s", planned, refreshed)
 	}
+}
 
-	if rd.Id() != "alarm-1" {
-		t.Errorf("got id %q, want alarm-1", rd.Id())
+// upgradeResourceState hands state written at schema version 0 — every
+// SDKv2 release — to the server, as Terraform does after a provider upgrade.
+func upgradeResourceState(t *testing.T, server tfprotov5.ProviderServer, state string) (string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	resp, err := server.UpgradeResourceState(t.Context(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: instanceAlarmType,
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(state)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.UpgradedState == nil {
+		return "", resp.Diagnostics
+	}
+	return stateJSON(t, resourceValueType(t, server, instanceAlarmType), resp.UpgradedState), resp.Diagnostics
+}
+
+func TestResourceInstanceAlarmCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"createInstanceAlarm": createAlarmResponse("alarm-1"),
+		"getInstanceAlarm":    alarmReadResponse(nil),
+	})
+
+	state, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, "", alarmConfig)
+	requireNoDiagnostics(t, diags)
+	if !strings.Contains(state, `"id":"alarm-1"`) {
+		t.Errorf("got state %s, want id alarm-1", state)
 	}
 
 	createReq := rec.FindRequest("createInstanceAlarm")
@@ -123,41 +261,22 @@ func TestResourceInstanceAlarmCreate(t *testing.T) {
 
 // Alertmanager and some GCP alarms have no comparison_operator/threshold/period/metric.
 // We must omit them from the API request rather than send zero values that would cause
-// the backend to reject or store nonsense values.
+// the backend to reject or store nonsense values — and keep them null in state, so the
+// next plan doesn't propose setting them back to null.
 func TestResourceInstanceAlarmCreateOmitsUnsetOptionalFields(t *testing.T) {
 	pc, rec := newMockProvider(map[string]map[string]any{
-		"createInstanceAlarm": {
-			"data": map[string]any{
-				"createInstanceAlarm": map[string]any{
-					"result": map[string]any{
-						"id":              "alarm-2",
-						"displayName":     "Alertmanager Page",
-						"cloudResourceId": "alertmanager:my-alert",
-					},
-					"successful": true,
-				},
-			},
-		},
-		"getInstanceAlarm": {
-			"data": map[string]any{
-				"instanceAlarm": map[string]any{
-					"id":              "alarm-2",
-					"displayName":     "Alertmanager Page",
-					"cloudResourceId": "alertmanager:my-alert",
-				},
-			},
-		},
+		"createInstanceAlarm": createAlarmResponse("alarm-1"),
+		"getInstanceAlarm":    unsetAlarmReadResponse,
 	})
+	server := newFrameworkServer(t, pc)
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		"instance_id":       "ecomm-prod-db",
-		"display_name":      "Alertmanager Page",
+	state, diags := applyResource(t, server, instanceAlarmType, "", `{
+		"instance_id": "ecomm-prod-db",
+		"display_name": "Alertmanager Page",
 		"cloud_resource_id": "alertmanager:my-alert",
-	})
-
-	if diags := resourceInstanceAlarmCreate(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
-	}
+		"metric": []
+	}`)
+	requireNoDiagnostics(t, diags)
 
 	input, _ := gqlmock.Variables(rec.FindRequest("createInstanceAlarm"))["input"].(map[string]any)
 	for _, omitted := range []string{"comparisonOperator", "threshold", "period", "metric"} {
@@ -165,12 +284,73 @@ func TestResourceInstanceAlarmCreateOmitsUnsetOptionalFields(t *testing.T) {
 			t.Errorf("input.%s should be omitted when not set, got %v", omitted, input[omitted])
 		}
 	}
+
+	want := `{"cloud_resource_id":"alertmanager:my-alert","comparison_operator":null,"display_name":"Alertmanager Page","id":"alarm-1","instance_id":"ecomm-prod-db","metric":[],"period":null,"threshold":null}`
+	if state != want {
+		t.Errorf("after create\n got: %s\nwant: %s", state, want)
+	}
+	refreshed, diags := readResource(t, server, instanceAlarmType, state)
+	requireNoDiagnostics(t, diags)
+	if refreshed != want {
+		t.Errorf("after refresh\n got: %s\nwant: %s", refreshed, want)
+	}
+}
+
+// A threshold or period of zero is a real value, distinct from leaving the
+// attribute out, and reaches the API.
+func TestResourceInstanceAlarmCreateSendsExplicitZero(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"createInstanceAlarm": createAlarmResponse("alarm-1"),
+		"getInstanceAlarm":    unsetAlarmReadResponse,
+	})
+
+	state, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, "", `{
+		"instance_id": "ecomm-prod-db",
+		"display_name": "Alertmanager Page",
+		"cloud_resource_id": "alertmanager:my-alert",
+		"threshold": 0,
+		"period": 0,
+		"metric": []
+	}`)
+	requireNoDiagnostics(t, diags)
+
+	input, _ := gqlmock.Variables(rec.FindRequest("createInstanceAlarm"))["input"].(map[string]any)
+	if input["threshold"] != 0.0 || input["period"] != 0.0 {
+		t.Errorf("got threshold %v, period %v; want both sent as 0", input["threshold"], input["period"])
+	}
+	if !strings.Contains(state, `"period":0,"threshold":0`) {
+		t.Errorf("got state %s, want threshold and period 0", state)
+	}
 }
 
-// The DefaultFunc resolves instance_id from env: MASSDRIVER_INSTANCE_ID wins
-// outright; MASSDRIVER_PACKAGE_NAME is the fallback and gets the trailing
-// deployment suffix stripped (`bundtst-...-rbpt` → `bundtst-...`). HCL config
-// trumps both via standard SDK precedence.
+// With instance_id left out of the configuration it is planned from the
+// environment, like the SDKv2 DefaultFunc it replaces.
+func TestResourceInstanceAlarmInstanceIDFromEnv(t *testing.T) {
+	t.Setenv("MASSDRIVER_INSTANCE_ID", "")
+	t.Setenv("MASSDRIVER_PACKAGE_NAME", "ecomm-prod-db-x7kq")
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"createInstanceAlarm": createAlarmResponse("alarm-1"),
+		"getInstanceAlarm":    unsetAlarmReadResponse,
+	})
+
+	state, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, "", `{
+		"display_name": "Alertmanager Page",
+		"cloud_resource_id": "alertmanager:my-alert",
+		"metric": []
+	}`)
+	requireNoDiagnostics(t, diags)
+
+	if got := gqlmock.Variables(rec.FindRequest("createInstanceAlarm"))["instanceId"]; got != "ecomm-prod-db" {
+		t.Errorf("got instanceId %v, want ecomm-prod-db", got)
+	}
+	if !strings.Contains(state, `"instance_id":"ecomm-prod-db"`) {
+		t.Errorf("got state %s, want instance_id ecomm-prod-db", state)
+	}
+}
+
+// The env lookup resolves instance_id: MASSDRIVER_INSTANCE_ID wins outright;
+// MASSDRIVER_PACKAGE_NAME is the fallback and gets the trailing deployment
+// suffix stripped (`bundtst-...-rbpt` → `bundtst-...`). HCL config trumps both.
 func TestInstanceIDFromEnv(t *testing.T) {
 	cases := []struct {
 		name        string
@@ -206,14 +386,12 @@ func TestResourceInstanceAlarmCreateRequiresInstanceID(t *testing.T) {
 	t.Setenv("MASSDRIVER_PACKAGE_NAME", "")
 	pc, rec := newMockProvider(map[string]map[string]any{})
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		"display_name":      "x",
+	_, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, "", `{
+		"display_name": "x",
 		"cloud_resource_id": "arn:::x",
-		// no instance_id
-	})
-
-	diags := resourceInstanceAlarmCreate(t.Context(), rd, pc)
-	if !diags.HasError() {
+		"metric": []
+	}`)
+	if !hasErrorDiagnostic(diags) {
 		t.Fatal("expected error about missing instance_id, got none")
 	}
 	if !strings.Contains(diags[0].Summary, "instance_id") {
@@ -239,87 +417,71 @@ func TestResourceInstanceAlarmCreatePropagatesAPIFailure(t *testing.T) {
 		},
 	})
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		"instance_id":       "ecomm-prod-db",
-		"display_name":      "Dup",
+	state, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, "", `{
+		"instance_id": "ecomm-prod-db",
+		"display_name": "Dup",
 		"cloud_resource_id": "duplicate-arn",
-	})
-
-	diags := resourceInstanceAlarmCreate(t.Context(), rd, pc)
-	if !diags.HasError() {
+		"metric": []
+	}`)
+	if !hasErrorDiagnostic(diags) {
 		t.Fatal("expected error, got none")
 	}
-	if rd.Id() != "" {
-		t.Errorf("ID should not be set on failure, got %q", rd.Id())
+	if !strings.Contains(diags[0].Detail, "must be unique within instance") {
+		t.Errorf("got detail %q, want the API message", diags[0].Detail)
+	}
+	if state != "null" {
+		t.Errorf("nothing should be saved on failure, got %s", state)
 	}
 }
 
-func TestResourceInstanceAlarmRead(t *testing.T) {
+// When the API returns no metric, the resource should clear the metric block in state
+// rather than leaving a zero-valued one that would show up as drift on next plan.
+func TestResourceInstanceAlarmReadClearsMetricWhenAbsent(t *testing.T) {
 	pc, _ := newMockProvider(map[string]map[string]any{
-		"getInstanceAlarm": alarmReadResponse(nil),
+		"getInstanceAlarm": unsetAlarmReadResponse,
 	})
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{})
-	rd.SetId("alarm-1")
-
-	if diags := resourceInstanceAlarmRead(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
-	}
-
-	if rd.Get("display_name").(string) != "RDS High CPU" {
-		t.Errorf("got display_name %q", rd.Get("display_name"))
-	}
-	if rd.Get("threshold").(float64) != 80.0 {
-		t.Errorf("got threshold %v, want 80.0", rd.Get("threshold"))
-	}
-	if rd.Get("period").(int) != 300 {
-		t.Errorf("got period %v, want 300", rd.Get("period"))
-	}
+	// State already has a metric (e.g., user previously had one configured),
+	// then the API stopped returning it. Read should clear, not leak the old block.
+	state, diags := readResource(t, newFrameworkServer(t, pc), instanceAlarmType, `{
+		"id": "alarm-1",
+		"instance_id": "ecomm-prod-db",
+		"display_name": "Alertmanager Page",
+		"cloud_resource_id": "alertmanager:my-alert",
+		"metric": [{"namespace": "stale"}]
+	}`)
+	requireNoDiagnostics(t, diags)
 
-	metricList := rd.Get("metric").([]any)
-	if len(metricList) != 1 {
-		t.Fatalf("got %d metric blocks, want 1", len(metricList))
-	}
-	metric := metricList[0].(map[string]any)
-	if metric["namespace"] != "AWS/RDS" {
-		t.Errorf("got metric.namespace %v, want AWS/RDS", metric["namespace"])
-	}
-	dims := metric["dimensions"].(map[string]any)
-	if dims["DBInstanceIdentifier"] != "prod-db" {
-		t.Errorf("got dimensions %v", dims)
+	if !strings.Contains(state, `"metric":[]`) {
+		t.Errorf("metric should be empty when API returns no metric; got %s", state)
 	}
 }
 
-// When the API returns no metric, the resource should clear the metric block in state
-// rather than leaving a zero-valued one that would show up as drift on next plan.
-func TestResourceInstanceAlarmReadClearsMetricWhenAbsent(t *testing.T) {
+func TestResourceInstanceAlarmImport(t *testing.T) {
 	pc, _ := newMockProvider(map[string]map[string]any{
-		"getInstanceAlarm": {
-			"data": map[string]any{
-				"instanceAlarm": map[string]any{
-					"id":              "alarm-no-metric",
-					"displayName":     "Alertmanager Page",
-					"cloudResourceId": "alertmanager:my-alert",
-				},
-			},
-		},
+		"getInstanceAlarm": alarmReadResponse(nil),
 	})
+	server := newFrameworkServer(t, pc)
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		// State already has a metric (e.g., user previously had one configured),
-		// then the API stopped returning it. Read should clear, not leak the old block.
-		"metric": []any{
-			map[string]any{"namespace": "stale"},
-		},
+	resp, err := server.ImportResourceState(t.Context(), &tfprotov5.ImportResourceStateRequest{
+		TypeName: instanceAlarmType,
+		ID:       "alarm-1",
 	})
-	rd.SetId("alarm-no-metric")
-
-	if diags := resourceInstanceAlarmRead(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
+	if err != nil {
+		t.Fatal(err)
 	}
+	requireNoDiagnostics(t, resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("got %d imported resources, want 1", len(resp.ImportedResources))
+	}
+	imported := stateJSON(t, resourceValueType(t, server, instanceAlarmType), resp.ImportedResources[0].State)
 
-	if metric := rd.Get("metric").([]any); len(metric) != 0 {
-		t.Errorf("metric should be empty when API returns no metric; got %+v", metric)
+	state, diags := readResource(t, server, instanceAlarmType, imported)
+	requireNoDiagnostics(t, diags)
+	for _, want := range []string{`"id":"alarm-1"`, `"display_name":"RDS High CPU"`, `"threshold":80`, `"DBInstanceIdentifier":"prod-db"`} {
+		if !strings.Contains(state, want) {
+			t.Errorf("imported state %s is missing %s", state, want)
+		}
 	}
 }
 
@@ -337,20 +499,12 @@ func TestResourceInstanceAlarmUpdate(t *testing.T) {
 				},
 			},
 		},
-		"getInstanceAlarm": alarmReadResponse(map[string]any{"displayName": "Renamed"}),
+		"getInstanceAlarm": alarmReadResponse(map[string]any{"displayName": "Renamed", "threshold": 95.0}),
 	})
 
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{
-		"instance_id":       "ecomm-prod-db",
-		"display_name":      "Renamed",
-		"cloud_resource_id": "arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu",
-		"threshold":         95.0,
-	})
-	rd.SetId("alarm-1")
-
-	if diags := resourceInstanceAlarmUpdate(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
-	}
+	config := strings.NewReplacer(`"RDS High CPU"`, `"Renamed"`, `"threshold": 80`, `"threshold": 95`).Replace(alarmConfig)
+	state, diags := applyResource(t, newFrameworkServer(t, pc), instanceAlarmType, sdkv2AlarmState, config)
+	requireNoDiagnostics(t, diags)
 
 	updateReq := rec.FindRequest("updateInstanceAlarm")
 	if updateReq == nil {
@@ -367,6 +521,9 @@ func TestResourceInstanceAlarmUpdate(t *testing.T) {
 	if input["threshold"] != 95.0 {
 		t.Errorf("got threshold %v, want 95.0", input["threshold"])
 	}
+	if !strings.Contains(state, `"display_name":"Renamed"`) || !strings.Contains(state, `"threshold":95`) {
+		t.Errorf("got state %s", state)
+	}
 }
 
 func TestResourceInstanceAlarmDelete(t *testing.T) {
@@ -380,47 +537,23 @@ func TestResourceInstanceAlarmDelete(t *testing.T) {
 			},
 		},
 	})
-
-	rd := schema.TestResourceDataRaw(t, resourceInstanceAlarm().Schema, map[string]any{})
-	rd.SetId("alarm-1")
-
-	if diags := resourceInstanceAlarmDelete(t.Context(), rd, pc); diags.HasError() {
-		t.Fatalf("unexpected diagnostics: %v", diags)
+	server := newFrameworkServer(t, pc)
+	typ := resourceValueType(t, server, instanceAlarmType)
+
+	resp, err := server.ApplyResourceChange(t.Context(), &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     instanceAlarmType,
+		PriorState:   dynamicValue(t, typ, sdkv2AlarmState),
+		PlannedState: dynamicValue(t, typ, ""),
+		Config:       dynamicValue(t, typ, ""),
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
-	if rd.Id() != "" {
-		t.Errorf("resource ID should be cleared, got %q", rd.Id())
+	requireNoDiagnostics(t, resp.Diagnostics)
+	if state := stateJSON(t, typ, resp.NewState); state != "null" {
+		t.Errorf("state should be removed, got %s", state)
 	}
 	if vars := gqlmock.Variables(rec.FindRequest("deleteInstanceAlarm")); vars["id"] != "alarm-1" {
 		t.Errorf("got id %v, want alarm-1", vars["id"])
 	}
 }
-
-func TestResourceInstanceAlarmSchema(t *testing.T) {
-	r := resourceInstanceAlarm()
-	if err := r.InternalValidate(nil, true); err != nil {
-		t.Fatalf("schema invalid: %v", err)
-	}
-	// instance_id is Optional (resolved by DefaultFunc from env in deployments)
-	// but ForceNew — moving an alarm between instances is destroy+recreate.
-	if iid := r.Schema["instance_id"]; iid == nil || iid.Required || !iid.Optional || !iid.ForceNew || iid.DefaultFunc == nil {
-		t.Error("instance_id should be Optional+ForceNew with a DefaultFunc")
-	}
-	for _, field := range []string{"display_name", "cloud_resource_id"} {
-		if !r.Schema[field].Required {
-			t.Errorf("%s should be Required", field)
-		}
-	}
-	for _, field := range []string{"comparison_operator", "threshold", "period", "metric"} {
-		s := r.Schema[field]
-		if s == nil {
-			t.Fatalf("expected %s in schema", field)
-		}
-		if !s.Optional {
-			t.Errorf("%s should be Optional", field)
-		}
-	}
-	// metric is a single-instance nested block.
-	if metric := r.Schema["metric"]; metric.MaxItems != 1 || metric.Type != schema.TypeList {
-		t.Errorf("metric should be TypeList with MaxItems=1, got Type=%v MaxItems=%d", metric.Type, metric.MaxItems)
-	}
-}
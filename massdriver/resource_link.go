@@ -0,0 +1,163 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLink() *schema.Resource {
+	return &schema.Resource{
+		Description: "Links an output field of one blueprint component to an input field of another. Links cannot be modified in place; any change replaces the link. Import with `<project_id>/<from_component_identifier>/<link_id>`; the API does not report version constraints, so imported links leave both empty and the first apply records the configured ones without replacing the link.",
+
+		CreateContext: resourceLinkCreate,
+		ReadContext:   resourceLinkRead,
+		UpdateContext: resourceLinkUpdate,
+		DeleteContext: resourceLinkDelete,
+		CustomizeDiff: resourceLinkCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLinkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"from_component_id": {
+				Description: "ID of the component producing the resource (e.g. `ecomm-db`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"from_field": {
+				Description: "Output field on the source component (e.g. `authentication`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"from_version": {
+				Description: "Version constraint for the source component's bundle (e.g. `~1.0`, `1.2.3`, `latest`).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "latest",
+			},
+			"to_component_id": {
+				Description: "ID of the component consuming the resource (e.g. `ecomm-app`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"to_field": {
+				Description: "Input field on the destination component (e.g. `database`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"to_version": {
+				Description: "Version constraint for the destination component's bundle (e.g. `~2.0`, `1.2.3`, `latest`).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "latest",
+			},
+		},
+	}
+}
+
+func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	link, err := api.LinkComponents(ctx, client, api.LinkComponentsInput{
+		FromComponentId: d.Get("from_component_id").(string),
+		FromField:       d.Get("from_field").(string),
+		FromVersion:     d.Get("from_version").(string),
+		ToComponentId:   d.Get("to_component_id").(string),
+		ToField:         d.Get("to_field").(string),
+		ToVersion:       d.Get("to_version").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(link.ID)
+	return resourceLinkRead(ctx, d, meta)
+}
+
+// resourceLinkRead refreshes everything except the version constraints,
+// which the API does not return; those keep whatever is already in state.
+func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	link, err := api.GetLink(ctx, client, d.Get("from_component_id").(string), d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("from_component_id", link.FromComponent.ID)
+	d.Set("from_field", link.FromField)
+	d.Set("to_component_id", link.ToComponent.ID)
+	d.Set("to_field", link.ToField)
+	return nil
+}
+
+// resourceLinkUpdate only records the version constraints of an imported
+// link; every other change replaces the link (see resourceLinkCustomizeDiff).
+func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceLinkRead(ctx, d, meta)
+}
+
+// resourceLinkCustomizeDiff replaces the link when a version constraint
+// changes, as for every other attribute, except when the prior value is
+// empty: the link was imported and its constraints are unknown, so the
+// configured ones are recorded without relinking.
+func resourceLinkCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, key := range []string{"from_version", "to_version"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		if prior, _ := d.GetChange(key); prior.(string) != "" {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceLinkDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if _, err := api.UnlinkComponents(ctx, client, d.Id()); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLinkImport accepts `<project_id>/<from_component_identifier>/<link_id>`.
+// The source component is needed because links can only be read through it.
+// The API cannot report the version constraints a link was created with, so
+// both are left empty for the first apply to fill in from config.
+func resourceLinkImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q: expected <project_id>/<from_component_identifier>/<link_id>", d.Id())
+	}
+
+	d.SetId(parts[2])
+	d.Set("from_component_id", parts[0]+"-"+parts[1])
+	d.Set("from_version", "")
+	d.Set("to_version", "")
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,185 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+const testLinkID = "22222222-2222-2222-2222-222222222222"
+
+func linkReadResponse(items ...map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"component": map[string]any{
+				"project": map[string]any{
+					"blueprint": map[string]any{
+						"links": map[string]any{
+							"cursor": map[string]any{"next": nil},
+							"items":  items,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourceLinkCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"linkComponents": {
+			"data": map[string]any{
+				"linkComponents": map[string]any{
+					"result":     map[string]any{"id": testLinkID, "fromField": "network", "toField": "network"},
+					"successful": true,
+				},
+			},
+		},
+		"getComponentLinks": linkReadResponse(map[string]any{
+			"id":            testLinkID,
+			"fromField":     "network",
+			"toField":       "network",
+			"fromComponent": map[string]any{"id": "ecomm-net"},
+			"toComponent":   map[string]any{"id": "ecomm-db"},
+		}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceLink().Schema, map[string]any{
+		"from_component_id": "ecomm-net",
+		"from_field":        "network",
+		"from_version":      "~1.0",
+		"to_component_id":   "ecomm-db",
+		"to_field":          "network",
+	})
+
+	if diags := resourceLinkCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != testLinkID {
+		t.Errorf("got id %q, want %s", rd.Id(), testLinkID)
+	}
+
+	input := gqlmock.Variables(rec.FindRequest("linkComponents"))["input"].(map[string]any)
+	if input["fromVersion"] != "~1.0" || input["toVersion"] != "latest" {
+		t.Errorf("got version constraints from=%v to=%v", input["fromVersion"], input["toVersion"])
+	}
+	// Version constraints aren't readable, so Read must leave them alone.
+	if rd.Get("from_version") != "~1.0" {
+		t.Errorf("from_version should survive read, got %v", rd.Get("from_version"))
+	}
+}
+
+func TestResourceLinkReadClearsWhenMissing(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getComponentLinks": linkReadResponse(),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceLink().Schema, map[string]any{
+		"from_component_id": "ecomm-net",
+	})
+	rd.SetId(testLinkID)
+
+	if diags := resourceLinkRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when the link is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceLinkImport(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, resourceLink().Schema, map[string]any{})
+	rd.SetId("ecomm/net/" + testLinkID)
+
+	out, err := resourceLinkImport(t.Context(), rd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Id() != testLinkID {
+		t.Errorf("got id %q, want %s", out[0].Id(), testLinkID)
+	}
+	if out[0].Get("from_component_id") != "ecomm-net" {
+		t.Errorf("got from_component_id %v, want ecomm-net", out[0].Get("from_component_id"))
+	}
+
+	bad := schema.TestResourceDataRaw(t, resourceLink().Schema, map[string]any{})
+	bad.SetId(testLinkID)
+	if _, err := resourceLinkImport(t.Context(), bad, nil); err == nil {
+		t.Error("a bare link ID should be rejected")
+	}
+}
+
+// The API can't report an imported link's version constraints, so the first
+// apply records the configured ones without relinking, and a later version
+// change still replaces the link.
+func TestResourceLinkPlanAfterImport(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getComponentLinks": linkReadResponse(map[string]any{
+			"id":            testLinkID,
+			"fromField":     "authentication",
+			"toField":       "database",
+			"fromComponent": map[string]any{"id": "ecomm-db"},
+			"toComponent":   map[string]any{"id": "ecomm-app"},
+		}),
+	})
+	server := newSDKServer(pc)
+
+	imported, diags := importResource(t, server, "massdriver_link", "ecomm/db/"+testLinkID)
+	requireNoDiagnostics(t, diags)
+	refreshed, diags := readResource(t, server, "massdriver_link", imported)
+	requireNoDiagnostics(t, diags)
+	if !strings.Contains(refreshed, `"from_version":""`) || !strings.Contains(refreshed, `"to_version":""`) {
+		t.Fatalf("got state %s, want empty version constraints after import", refreshed)
+	}
+
+	config := func(fromVersion string) string {
+		return `{
+			"from_component_id": "ecomm-db", "from_field": "authentication", "from_version": "` + fromVersion + `",
+			"to_component_id": "ecomm-app", "to_field": "database"
+		}`
+	}
+
+	plan := planResourceChange(t, server, "massdriver_link", refreshed, config("~1.0"))
+	requireNoDiagnostics(t, plan.Diagnostics)
+	if len(plan.RequiresReplace) != 0 {
+		t.Fatalf("the first plan after import should not replace the link, got %v", plan.RequiresReplace)
+	}
+	applied, diags := applyResource(t, server, "massdriver_link", refreshed, config("~1.0"))
+	requireNoDiagnostics(t, diags)
+	if !strings.Contains(applied, `"from_version":"~1.0"`) || !strings.Contains(applied, `"to_version":"latest"`) {
+		t.Errorf("got state %s, want the configured constraints", applied)
+	}
+	for _, op := range []string{"linkComponents", "unlinkComponents"} {
+		if rec.FindRequest(op) != nil {
+			t.Errorf("recording the constraints should not call %s", op)
+		}
+	}
+
+	plan = planResourceChange(t, server, "massdriver_link", applied, config("~1.0"))
+	requireNoDiagnostics(t, plan.Diagnostics)
+	if planned := stateJSON(t, resourceValueType(t, server, "massdriver_link"), plan.PlannedState); planned != applied {
+		t.Errorf("an unchanged config should plan nothing:\n got %s\nwant %s", planned, applied)
+	}
+
+	plan = planResourceChange(t, server, "massdriver_link", applied, config("~2.0"))
+	requireNoDiagnostics(t, plan.Diagnostics)
+	if len(plan.RequiresReplace) == 0 {
+		t.Error("a version change after import must replace the link")
+	}
+}
+
+func TestResourceLinkSchema(t *testing.T) {
+	r := resourceLink()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema invalid: %v", err)
+	}
+	// The version constraints are replaced by CustomizeDiff, which lets an
+	// imported link record them.
+	for name, s := range r.Schema {
+		if versioned := name == "from_version" || name == "to_version"; s.ForceNew == versioned {
+			t.Errorf("%s: got ForceNew %v (links have no update mutation)", name, s.ForceNew)
+		}
+	}
+}
@@ -23,6 +23,10 @@ func resourcePackageAlarm() *schema.Resource {
 		UpdateContext: resourcePackageAlarmUpdate,
 		DeleteContext: resourcePackageAlarmDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageAlarmImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_resource_id": {
 				Description: "The identifier of the alarm. In Azure it will be the id, GCP will be the name, and in AWS it will be the arn",
@@ -162,11 +166,11 @@ func getPackageShortName(d *schema.ResourceData) (string, error) {
 	if fullName == "" {
 		return "", fmt.Errorf("`package_id` must be set in config or MASSDRIVER_PACKAGE_NAME must be set in the environment")
 	}
-	parts := strings.Split(fullName, "-")
-	if len(parts) < 2 {
+	id, ok := instanceIDFromPackageName(fullName)
+	if !ok {
 		return "", fmt.Errorf("`package_id` %q must contain at least one hyphen", fullName)
 	}
-	return strings.Join(parts[:len(parts)-1], "-"), nil
+	return id, nil
 }
 
 // resourcePackageAlarmRead hydrates state from the GraphQL instance_alarm
@@ -230,6 +234,38 @@ func resourcePackageAlarmRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// resourcePackageAlarmImport accepts `<package_id>/<alarm_id>`, or a bare
+// alarm ID inside a deployment where MASSDRIVER_PACKAGE_NAME supplies the
+// package. `package_id` is ForceNew, so it has to land in state here or the
+// first plan after import would replace the alarm. The alarm is looked up
+// up front so a wrong ID fails the import rather than silently importing
+// nothing.
+func resourcePackageAlarmImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	packageID, alarmID, found := strings.Cut(d.Id(), "/")
+	if !found {
+		alarmID = d.Id()
+		packageID = os.Getenv("MASSDRIVER_PACKAGE_NAME")
+		if packageID == "" {
+			return nil, fmt.Errorf("expected import ID `<package_id>/<alarm_id>` (or a bare alarm ID with MASSDRIVER_PACKAGE_NAME set), got %q", d.Id())
+		}
+	}
+	if packageID == "" || alarmID == "" {
+		return nil, fmt.Errorf("expected import ID `<package_id>/<alarm_id>`, got %q", d.Id())
+	}
+	if isLegacyTimestampID(alarmID) {
+		return nil, fmt.Errorf("alarm %s has a pre-UUID timestamp ID and cannot be imported; recreate it instead", alarmID)
+	}
+
+	client := meta.(*ProviderClient).Client
+	if _, err := api.GetInstanceAlarm(ctx, client, alarmID); err != nil {
+		return nil, fmt.Errorf("unable to import alarm %s: %w", alarmID, err)
+	}
+
+	d.SetId(alarmID)
+	d.Set("package_id", packageID)
+	return []*schema.ResourceData{d}, nil
+}
+
 // resourcePackageAlarmDelete deletes via the instance_alarm GraphQL endpoint.
 // Legacy timestamp-format IDs are simply dropped from state — the REST
 // endpoint that knew how to delete them is gone, and the underlying server-
@@ -305,7 +341,38 @@ func buildUpdateInstanceAlarmInput(d *schema.ResourceData) api.UpdateInstanceAla
 	return input
 }
 
-// parseAlarmMetric and stringFrom are defined in resource_instance_alarm.go
-// and shared with package_alarm. The shared parser also populates `Region`,
-// which the v1 package_alarm schema doesn't expose — empty Region is fine
-// because the GraphQL input directive drops empty values from the wire.
+// parseAlarmMetric converts the optional metric block from terraform's nested-list
+// representation into the API input. Returns nil when the block is omitted, which
+// makes the field disappear from the JSON body via `omitempty`. It also reads
+// `region`, which the v1 package_alarm schema doesn't expose — empty Region is
+// fine because the GraphQL input directive drops empty values from the wire.
+func parseAlarmMetric(block []any) *api.AlarmMetricInput {
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+	raw, ok := block[0].(map[string]any)
+	if !ok {
+		return nil
+	}
+	metric := &api.AlarmMetricInput{
+		Namespace: stringFrom(raw, "namespace"),
+		Name:      stringFrom(raw, "name"),
+		Statistic: stringFrom(raw, "statistic"),
+		Region:    stringFrom(raw, "region"),
+	}
+	if dims, ok := raw["dimensions"].(map[string]any); ok {
+		for k, v := range dims {
+			s, _ := v.(string)
+			metric.Dimensions = append(metric.Dimensions, api.AlarmMetricDimensionInput{
+				Name:  k,
+				Value: s,
+			})
+		}
+	}
+	return metric
+}
+
+func stringFrom(m map[string]any, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
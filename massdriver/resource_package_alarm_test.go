@@ -516,6 +516,79 @@ func TestResourcePackageAlarmReadShortCircuitsLegacyTimestampID(t *testing.T) {
 	}
 }
 
+func TestResourcePackageAlarmImport(t *testing.T) {
+	alarmResponse := map[string]map[string]any{
+		"getInstanceAlarm": {
+			"data": map[string]any{
+				"instanceAlarm": map[string]any{
+					"id":              "alarm-uuid",
+					"displayName":     "RDS High CPU",
+					"cloudResourceId": "arn:aws:cloudwatch:us-east-1:111:alarm/rds-cpu",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		importID    string
+		env         string
+		wantPackage string
+		wantErr     string
+	}{
+		{name: "package and alarm", importID: "ecomm-prod-db-rbpt/alarm-uuid", wantPackage: "ecomm-prod-db-rbpt"},
+		{name: "bare alarm inside a deployment", importID: "alarm-uuid", env: "ecomm-prod-db-rbpt", wantPackage: "ecomm-prod-db-rbpt"},
+		{name: "bare alarm outside a deployment", importID: "alarm-uuid", wantErr: "<package_id>/<alarm_id>"},
+		{name: "empty segment", importID: "ecomm-prod-db-rbpt/", wantErr: "<package_id>/<alarm_id>"},
+		{name: "legacy timestamp ID", importID: "ecomm-prod-db-rbpt/2021-04-15T12:00:00Z", wantErr: "pre-UUID"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("MASSDRIVER_PACKAGE_NAME", tc.env)
+			pc, rec := newMockProvider(alarmResponse)
+
+			rd := schema.TestResourceDataRaw(t, resourcePackageAlarm().Schema, map[string]any{})
+			rd.SetId(tc.importID)
+
+			_, err := resourcePackageAlarm().Importer.StateContext(t.Context(), rd, pc)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got %v, want error containing %q", err, tc.wantErr)
+				}
+				if len(rec.Requests) != 0 {
+					t.Errorf("invalid import IDs must not reach the API, got %d requests", len(rec.Requests))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rd.Id() != "alarm-uuid" {
+				t.Errorf("got ID %q, want alarm-uuid", rd.Id())
+			}
+			if rd.Get("package_id") != tc.wantPackage {
+				t.Errorf("got package_id %v, want %s", rd.Get("package_id"), tc.wantPackage)
+			}
+		})
+	}
+}
+
+func TestResourcePackageAlarmImportNotFound(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getInstanceAlarm": {
+			"data":   map[string]any{"instanceAlarm": nil},
+			"errors": []map[string]any{{"message": "not found"}},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourcePackageAlarm().Schema, map[string]any{})
+	rd.SetId("ecomm-prod-db-rbpt/alarm-uuid")
+
+	if _, err := resourcePackageAlarm().Importer.StateContext(t.Context(), rd, pc); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
 func TestResourcePackageAlarmDeleteViaGraphQL(t *testing.T) {
 	pc, rec := newMockProvider(map[string]map[string]any{
 		"deleteInstanceAlarm": {
@@ -0,0 +1,184 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// identifierPattern mirrors the server's rule for project, environment and
+// component identifiers so a bad ID fails at plan time instead of mid-apply.
+var identifierPattern = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a Massdriver project: the blueprint plus the environments it is deployed into. Destroying a project that still has environments fails with the list of blocking resources.",
+
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+
+		CustomizeDiff: resourceProjectCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "Short identifier for the project (max 20 characters, lowercase alphanumeric). Becomes the first segment of every instance identifier in the project (e.g. `ecomm` in `ecomm-prod-db`). Immutable.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(identifierPattern, "must be 1-20 lowercase alphanumeric characters"),
+			},
+			"name": {
+				Description: "Human-readable name for the project. Must be unique within the organization.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Free-text description of what the project is for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attributes": {
+				Description: "Key-value attributes assigned to the project. Must conform to the organization's custom attributes for the `PROJECT` scope. Attributes cascade to environments and instances.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"clone_from": {
+				Description: "ID of an existing project whose blueprint (components and links) is copied into the new project at creation. Environments are not cloned. Changing this forces a new project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// resourceProjectCustomizeDiff rejects a new project without an `id` at plan
+// time. `id` is Optional and Computed, but the server never generates one, so
+// leaving it out could otherwise only fail during apply.
+func resourceProjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() != "" {
+		return nil
+	}
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	if id := raw.GetAttr("id"); id.IsKnown() && id.IsNull() {
+		return fmt.Errorf("id must be set")
+	}
+	return nil
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	id := d.Get("id").(string)
+	if id == "" {
+		return diag.Errorf("id must be set")
+	}
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	attributes := d.Get("attributes").(map[string]any)
+
+	var project *api.Project
+	var err error
+	if source, ok := d.GetOk("clone_from"); ok {
+		project, err = api.CloneProject(ctx, client, source.(string), api.CloneProjectInput{
+			Id:          id,
+			Name:        name,
+			Description: description,
+			Attributes:  attributes,
+		})
+	} else {
+		project, err = api.CreateProject(ctx, client, api.CreateProjectInput{
+			Id:          id,
+			Name:        name,
+			Description: description,
+			Attributes:  attributes,
+		})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(project.ID)
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	project, err := api.GetProject(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("id", project.ID)
+	d.Set("name", project.Name)
+	d.Set("description", project.Description)
+	if err := d.Set("attributes", project.Attributes); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	input := api.UpdateProjectInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Attributes:  d.Get("attributes").(map[string]any),
+	}
+	if _, err := api.UpdateProject(ctx, client, d.Id(), input); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+// resourceProjectDelete checks `deletable` before calling deleteProject so a
+// blocked destroy lists what is in the way (typically environments) instead
+// of the bare mutation failure.
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	project, err := api.GetProject(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := project.Deletable.Err("project " + d.Id() + " cannot be deleted"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := api.DeleteProject(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
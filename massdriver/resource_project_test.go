@@ -0,0 +1,251 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+// projectReadResponse is a canned getProject response used after Create/Update.
+func projectReadResponse(deletable map[string]any) map[string]any {
+	if deletable == nil {
+		deletable = map[string]any{"result": true, "constraints": []any{}}
+	}
+	return map[string]any{
+		"data": map[string]any{
+			"project": map[string]any{
+				"id":          "ecomm",
+				"name":        "E-Commerce",
+				"description": "Storefront",
+				"attributes":  map[string]any{"team": "payments"},
+				"deletable":   deletable,
+			},
+		},
+	}
+}
+
+func TestResourceProjectCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"createProject": {
+			"data": map[string]any{
+				"createProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm", "name": "E-Commerce"},
+					"successful": true,
+				},
+			},
+		},
+		"getProject": projectReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{
+		"id":          "ecomm",
+		"name":        "E-Commerce",
+		"description": "Storefront",
+		"attributes":  map[string]any{"team": "payments"},
+	})
+
+	if diags := resourceProjectCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "ecomm" {
+		t.Errorf("got id %q, want ecomm", rd.Id())
+	}
+	if rec.FindRequest("cloneProject") != nil {
+		t.Error("cloneProject must not fire without clone_from")
+	}
+
+	vars := gqlmock.Variables(rec.FindRequest("createProject"))
+	if vars["organizationId"] != testOrgID {
+		t.Errorf("got organizationId %v, want %s", vars["organizationId"], testOrgID)
+	}
+	input := vars["input"].(map[string]any)
+	if input["id"] != "ecomm" || input["name"] != "E-Commerce" || input["description"] != "Storefront" {
+		t.Errorf("got input %v", input)
+	}
+	if rd.Get("attributes").(map[string]any)["team"] != "payments" {
+		t.Errorf("got attributes %v", rd.Get("attributes"))
+	}
+}
+
+func TestResourceProjectCreateClonesWhenCloneFromSet(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"cloneProject": {
+			"data": map[string]any{
+				"cloneProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm", "name": "E-Commerce"},
+					"successful": true,
+				},
+			},
+		},
+		"getProject": projectReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{
+		"id":         "ecomm",
+		"name":       "E-Commerce",
+		"clone_from": "template",
+	})
+
+	if diags := resourceProjectCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rec.FindRequest("createProject") != nil {
+		t.Error("createProject must not fire when clone_from is set")
+	}
+	if got := gqlmock.Variables(rec.FindRequest("cloneProject"))["sourceProjectId"]; got != "template" {
+		t.Errorf("got sourceProjectId %v, want template", got)
+	}
+	// clone_from isn't returned by the API; it must survive the post-create Read.
+	if rd.Get("clone_from").(string) != "template" {
+		t.Errorf("clone_from should be preserved, got %q", rd.Get("clone_from"))
+	}
+}
+
+func TestResourceProjectReadClearsWhenMissing(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getProject": {"data": map[string]any{"project": nil}},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{})
+	rd.SetId("ecomm")
+
+	if diags := resourceProjectRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when project is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceProjectUpdate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"updateProject": {
+			"data": map[string]any{
+				"updateProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm", "name": "Renamed"},
+					"successful": true,
+				},
+			},
+		},
+		"getProject": projectReadResponse(nil),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{
+		"id":   "ecomm",
+		"name": "Renamed",
+	})
+	rd.SetId("ecomm")
+
+	if diags := resourceProjectUpdate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	vars := gqlmock.Variables(rec.FindRequest("updateProject"))
+	if vars["id"] != "ecomm" {
+		t.Errorf("got id %v, want ecomm", vars["id"])
+	}
+	input := vars["input"].(map[string]any)
+	if input["name"] != "Renamed" {
+		t.Errorf("got input.name %v, want Renamed", input["name"])
+	}
+	// Description is always sent so removing it from HCL clears it server-side.
+	if desc, ok := input["description"]; !ok || desc != "" {
+		t.Errorf("description should be sent as empty string, got %v (present=%v)", desc, ok)
+	}
+}
+
+func TestResourceProjectDelete(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getProject": projectReadResponse(nil),
+		"deleteProject": {
+			"data": map[string]any{
+				"deleteProject": map[string]any{
+					"result":     map[string]any{"id": "ecomm"},
+					"successful": true,
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{})
+	rd.SetId("ecomm")
+
+	if diags := resourceProjectDelete(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared after delete, got %q", rd.Id())
+	}
+	if rec.FindRequest("deleteProject") == nil {
+		t.Error("deleteProject should fire when the project is deletable")
+	}
+}
+
+// A project with environments can't be deleted. Destroy must report every
+// blocking constraint and never attempt the mutation.
+func TestResourceProjectDeleteReportsConstraints(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"getProject": projectReadResponse(map[string]any{
+			"result": false,
+			"constraints": []map[string]any{
+				{"message": "Project has environments", "type": "environment", "id": "ecomm-prod"},
+				{"message": "Project has environments", "type": "environment", "id": "ecomm-staging"},
+			},
+		}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceProject().Schema, map[string]any{})
+	rd.SetId("ecomm")
+
+	diags := resourceProjectDelete(t.Context(), rd, pc)
+	if !diags.HasError() {
+		t.Fatal("expected deletion-constraint error, got none")
+	}
+	for _, want := range []string{"ecomm-prod", "ecomm-staging", "cannot be deleted"} {
+		if !strings.Contains(diags[0].Summary, want) {
+			t.Errorf("error %q should mention %q", diags[0].Summary, want)
+		}
+	}
+	if rec.FindRequest("deleteProject") != nil {
+		t.Error("deleteProject must not fire while constraints block deletion")
+	}
+	if rd.Id() != "ecomm" {
+		t.Errorf("ID should be kept when deletion is blocked, got %q", rd.Id())
+	}
+}
+
+func TestResourceProjectPlanRequiresID(t *testing.T) {
+	pc, rec := newMockProvider(nil)
+	server := newSDKServer(pc)
+
+	_, diags := planResource(t, server, "massdriver_project", "", `{"name": "E-Commerce"}`)
+	if !hasErrorDiagnostic(diags) {
+		t.Fatal("expected a plan error for a project without an id")
+	}
+	if !strings.Contains(diags[0].Summary, "id must be set") {
+		t.Errorf("got diagnostic %q, want it to mention the missing id", diags[0].Summary)
+	}
+	if len(rec.Requests) != 0 {
+		t.Errorf("plan must not call the API, got %d requests", len(rec.Requests))
+	}
+
+	plan := planResourceChange(t, server, "massdriver_project", "", `{"id": "ecomm", "name": "E-Commerce"}`)
+	requireNoDiagnostics(t, plan.Diagnostics)
+}
+
+func TestResourceProjectSchema(t *testing.T) {
+	r := resourceProject()
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema invalid: %v", err)
+	}
+	if r.Importer == nil {
+		t.Error("massdriver_project should be importable")
+	}
+	if id := r.Schema["id"]; !id.ForceNew {
+		t.Error("id should be ForceNew (project identifiers are immutable)")
+	}
+	if cf := r.Schema["clone_from"]; !cf.ForceNew {
+		t.Error("clone_from should be ForceNew")
+	}
+}
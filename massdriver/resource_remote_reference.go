@@ -0,0 +1,196 @@
+package massdriver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
+)
+
+func resourceRemoteReference() *schema.Resource {
+	return &schema.Resource{
+		Description: "Wires a resource from another project, or an imported resource, into one of an instance's connection slots without a blueprint link. The instance must not be provisioned or failed when the reference is set or removed. Import with `<instance_id>:<field>`.",
+
+		CreateContext: resourceRemoteReferenceCreate,
+		ReadContext:   resourceRemoteReferenceRead,
+		UpdateContext: resourceRemoteReferenceUpdate,
+		DeleteContext: resourceRemoteReferenceDelete,
+		CustomizeDiff: resourceRemoteReferenceCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRemoteReferenceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Description: "ID of the instance consuming the resource (e.g. `ecomm-prod-app`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"field": {
+				Description: "Connection field on the instance's bundle to fill (e.g. `vpc`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"resource_id": {
+				Description: "Resource to reference: the UUID of an imported resource, or `<instance_id>.<field>` for a resource provisioned by another instance. Pointing it at a different resource replaces the reference; naming the same resource the other way (as after `terraform import`, which records the UUID) only updates state.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_name": {
+				Description: "Name of the referenced resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceRemoteReferenceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	instanceID := d.Get("instance_id").(string)
+	field := d.Get("field").(string)
+	if _, err := api.SetRemoteReference(ctx, client, instanceID, d.Get("resource_id").(string), field); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID + ":" + field)
+	return resourceRemoteReferenceRead(ctx, d, meta)
+}
+
+// resourceRemoteReferenceRead looks the field up in the instance's
+// dependencies. A slot filled by a blueprint connection means the reference
+// is not what the instance will consume, which is reported as a warning
+// rather than drift so the override isn't silently re-applied.
+func resourceRemoteReferenceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	instanceID := d.Get("instance_id").(string)
+	field := d.Get("field").(string)
+	deps, err := api.GetInstanceDependencies(ctx, client, instanceID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for _, dep := range deps {
+		if dep.Field != field {
+			continue
+		}
+		switch dep.Source.Kind {
+		case api.DependencySourceRemoteReference:
+			same, err := sameResource(ctx, client, d.Get("resource_id").(string), dep.Resource.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !same {
+				d.Set("resource_id", dep.Resource.ID)
+			}
+			d.Set("resource_name", dep.Resource.Name)
+			return nil
+		case api.DependencySourceConnection:
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Remote reference is shadowed by a blueprint connection",
+				Detail: fmt.Sprintf(
+					"Field %q on instance %s is filled by a blueprint connection from %s.%s (resource %s), not by the remote reference to %s. Remove the blueprint link, or remove this resource if the connection is intended.",
+					field, instanceID, dep.Source.FromInstanceID, dep.Source.FromField, dep.Resource.ID, d.Get("resource_id").(string),
+				),
+			}}
+		}
+	}
+
+	// The field is empty or falls back to an environment default: the
+	// reference was removed outside Terraform.
+	d.SetId("")
+	return nil
+}
+
+// sameResource reports whether the configured resource_id names the resource
+// the API reports. The API answers with the resource's own ID, while the
+// config may use the `<instance_id>.<field>` form, so a differing value is
+// resolved before it is treated as drift.
+func sameResource(ctx context.Context, mdClient *client.Client, configured, actual string) (bool, error) {
+	if configured == actual {
+		return true, nil
+	}
+	if configured == "" {
+		return false, nil
+	}
+	resource, err := api.GetResource(ctx, mdClient, configured)
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return resource.ID == actual, nil
+}
+
+// resourceRemoteReferenceUpdate only records a new spelling of the same
+// resource; resourceRemoteReferenceCustomizeDiff replaces the reference for
+// anything else, and the API rejects changes to a provisioned instance.
+func resourceRemoteReferenceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return resourceRemoteReferenceRead(ctx, d, meta)
+}
+
+// resourceRemoteReferenceCustomizeDiff replaces the reference when
+// resource_id names a different resource. The value in state is the one the
+// API reports, which for a reference configured as `<instance_id>.<field>`
+// differs after an import, so the two are resolved before being compared.
+func resourceRemoteReferenceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.HasChange("resource_id") {
+		return nil
+	}
+	if !d.NewValueKnown("resource_id") {
+		return d.ForceNew("resource_id")
+	}
+	prior, configured := d.GetChange("resource_id")
+	same, err := sameResource(ctx, meta.(*ProviderClient).Client, configured.(string), prior.(string))
+	if err != nil {
+		return err
+	}
+	if !same {
+		return d.ForceNew("resource_id")
+	}
+	return nil
+}
+
+func resourceRemoteReferenceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	if _, err := api.RemoveRemoteReference(ctx, client, d.Get("instance_id").(string), d.Get("field").(string)); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceRemoteReferenceImport accepts `<instance_id>:<field>`, which is also
+// the resource ID.
+func resourceRemoteReferenceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	instanceID, field, ok := strings.Cut(d.Id(), ":")
+	if !ok || instanceID == "" || field == "" {
+		return nil, fmt.Errorf("invalid import ID %q: expected <instance_id>:<field>", d.Id())
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("field", field)
+	return []*schema.ResourceData{d}, nil
+}
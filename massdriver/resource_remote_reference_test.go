@@ -0,0 +1,265 @@
+package massdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+func instanceDependenciesResponse(deps ...map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"instance": map[string]any{
+				"id":           "ecomm-prod-app",
+				"dependencies": deps,
+			},
+		},
+	}
+}
+
+var remoteVPCDependency = map[string]any{
+	"field":    "vpc",
+	"resource": map[string]any{"id": "vpc-1", "name": "Shared VPC"},
+	"source":   map[string]any{"__typename": "RemoteReference", "id": "r-1"},
+}
+
+func TestResourceRemoteReferenceCreate(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"setRemoteReference": {
+			"data": map[string]any{
+				"setRemoteReference": map[string]any{
+					"result":     map[string]any{"id": "r-1", "field": "vpc", "resource": map[string]any{"id": "vpc-1"}},
+					"successful": true,
+				},
+			},
+		},
+		"getInstanceDependencies": instanceDependenciesResponse(remoteVPCDependency),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{
+		"instance_id": "ecomm-prod-app",
+		"field":       "vpc",
+		"resource_id": "vpc-1",
+	})
+
+	if diags := resourceRemoteReferenceCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "ecomm-prod-app:vpc" {
+		t.Errorf("got id %q, want ecomm-prod-app:vpc", rd.Id())
+	}
+	if rd.Get("resource_name") != "Shared VPC" {
+		t.Errorf("got resource_name %v", rd.Get("resource_name"))
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("setRemoteReference")); vars["resourceId"] != "vpc-1" {
+		t.Errorf("got variables %v", vars)
+	}
+}
+
+// A reference configured as `<instance_id>.<field>` is reported back by the
+// resource's own ID; Read must keep the configured form when both name the
+// same resource, and report drift when they don't.
+func TestResourceRemoteReferenceReadKeepsInstanceFieldForm(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolvedID string
+		want       string
+	}{
+		{name: "same_resource", resolvedID: "vpc-1", want: "ecomm-shared-net.vpc"},
+		{name: "different_resource", resolvedID: "vpc-2", want: "vpc-1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pc, rec := newMockProvider(map[string]map[string]any{
+				"getInstanceDependencies": instanceDependenciesResponse(remoteVPCDependency),
+				"getResource":             getResourceResponse(tc.resolvedID, "aws-vpc"),
+			})
+
+			rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{
+				"instance_id": "ecomm-prod-app",
+				"field":       "vpc",
+				"resource_id": "ecomm-shared-net.vpc",
+			})
+			rd.SetId("ecomm-prod-app:vpc")
+
+			if diags := resourceRemoteReferenceRead(t.Context(), rd, pc); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := rd.Get("resource_id"); got != tc.want {
+				t.Errorf("got resource_id %v, want %s", got, tc.want)
+			}
+			if vars := gqlmock.Variables(rec.FindRequest("getResource")); vars["id"] != "ecomm-shared-net.vpc" {
+				t.Errorf("got getResource variables %v", vars)
+			}
+		})
+	}
+}
+
+func TestResourceRemoteReferenceReadShadowedByConnection(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getInstanceDependencies": instanceDependenciesResponse(map[string]any{
+			"field":    "vpc",
+			"resource": map[string]any{"id": "ecomm-prod-net.network", "name": "Project VPC"},
+			"source": map[string]any{
+				"__typename":   "Connection",
+				"id":           "c-1",
+				"fromField":    "network",
+				"fromInstance": map[string]any{"id": "ecomm-prod-net"},
+			},
+		}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{
+		"instance_id": "ecomm-prod-app",
+		"field":       "vpc",
+		"resource_id": "vpc-1",
+	})
+	rd.SetId("ecomm-prod-app:vpc")
+
+	diags := resourceRemoteReferenceRead(t.Context(), rd, pc)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "ecomm-prod-net.network") {
+		t.Errorf("warning should name the shadowing connection, got %q", diags[0].Detail)
+	}
+	if rd.Id() == "" {
+		t.Error("a shadowed reference should stay in state")
+	}
+	if rd.Get("resource_id") != "vpc-1" {
+		t.Errorf("resource_id should keep the configured value, got %v", rd.Get("resource_id"))
+	}
+}
+
+func TestResourceRemoteReferenceReadClearsWhenRemoved(t *testing.T) {
+	pc, _ := newMockProvider(map[string]map[string]any{
+		"getInstanceDependencies": instanceDependenciesResponse(map[string]any{
+			"field":    "vpc",
+			"resource": map[string]any{"id": "vpc-default", "name": "Default VPC"},
+			"source":   map[string]any{"__typename": "EnvironmentDefault", "id": "d-1"},
+		}),
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{
+		"instance_id": "ecomm-prod-app",
+		"field":       "vpc",
+		"resource_id": "vpc-1",
+	})
+	rd.SetId("ecomm-prod-app:vpc")
+
+	if diags := resourceRemoteReferenceRead(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "" {
+		t.Errorf("ID should be cleared when the reference is gone, got %q", rd.Id())
+	}
+}
+
+func TestResourceRemoteReferenceDelete(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"removeRemoteReference": {
+			"data": map[string]any{
+				"removeRemoteReference": map[string]any{
+					"result":     map[string]any{"id": "r-1", "field": "vpc"},
+					"successful": true,
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{
+		"instance_id": "ecomm-prod-app",
+		"field":       "vpc",
+		"resource_id": "vpc-1",
+	})
+	rd.SetId("ecomm-prod-app:vpc")
+
+	if diags := resourceRemoteReferenceDelete(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	vars := gqlmock.Variables(rec.FindRequest("removeRemoteReference"))
+	if vars["instanceId"] != "ecomm-prod-app" || vars["input"].(map[string]any)["field"] != "vpc" {
+		t.Errorf("got variables %v", vars)
+	}
+}
+
+// Import records the UUID the API reports. A config naming the same
+// resource as `<instance_id>.<field>` then updates state only, while one
+// naming a different resource replaces the reference.
+func TestResourceRemoteReferencePlanAfterImport(t *testing.T) {
+	tests := []struct {
+		name        string
+		resolvedID  string
+		wantReplace bool
+	}{
+		{name: "same_resource", resolvedID: "vpc-1"},
+		{name: "different_resource", resolvedID: "vpc-2", wantReplace: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pc, rec := newMockProvider(map[string]map[string]any{
+				"getInstanceDependencies": instanceDependenciesResponse(remoteVPCDependency),
+				"getResource":             getResourceResponse(tc.resolvedID, "aws-vpc"),
+			})
+			server := newSDKServer(pc)
+
+			imported, diags := importResource(t, server, "massdriver_remote_reference", "ecomm-prod-app:vpc")
+			requireNoDiagnostics(t, diags)
+			refreshed, diags := readResource(t, server, "massdriver_remote_reference", imported)
+			requireNoDiagnostics(t, diags)
+			if !strings.Contains(refreshed, `"resource_id":"vpc-1"`) {
+				t.Fatalf("got state %s, want the resource's own ID", refreshed)
+			}
+
+			config := `{"instance_id":"ecomm-prod-app","field":"vpc","resource_id":"ecomm-shared-net.vpc"}`
+			plan := planResourceChange(t, server, "massdriver_remote_reference", refreshed, config)
+			requireNoDiagnostics(t, plan.Diagnostics)
+			if replace := len(plan.RequiresReplace) > 0; replace != tc.wantReplace {
+				t.Fatalf("got RequiresReplace %v, want replacement %v", plan.RequiresReplace, tc.wantReplace)
+			}
+			if tc.wantReplace {
+				return
+			}
+
+			applied, diags := applyResource(t, server, "massdriver_remote_reference", refreshed, config)
+			requireNoDiagnostics(t, diags)
+			if !strings.Contains(applied, `"resource_id":"ecomm-shared-net.vpc"`) {
+				t.Errorf("got state %s, want the configured resource_id", applied)
+			}
+			for _, op := range []string{"setRemoteReference", "removeRemoteReference"} {
+				if rec.FindRequest(op) != nil {
+					t.Errorf("recording the configured form should not call %s", op)
+				}
+			}
+			planned, diags := planResource(t, server, "massdriver_remote_reference", applied, config)
+			requireNoDiagnostics(t, diags)
+			if planned != applied {
+				t.Errorf("the next plan should be empty:\n got %s\nwant %s", planned, applied)
+			}
+		})
+	}
+}
+
+func TestResourceRemoteReferenceImport(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{})
+	rd.SetId("ecomm-prod-app:vpc")
+
+	out, err := resourceRemoteReferenceImport(t.Context(), rd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Get("instance_id") != "ecomm-prod-app" || out[0].Get("field") != "vpc" {
+		t.Errorf("got instance_id=%v field=%v", out[0].Get("instance_id"), out[0].Get("field"))
+	}
+
+	for _, bad := range []string{"ecomm-prod-app", ":vpc", "ecomm-prod-app:"} {
+		rd := schema.TestResourceDataRaw(t, resourceRemoteReference().Schema, map[string]any{})
+		rd.SetId(bad)
+		if _, err := resourceRemoteReferenceImport(t.Context(), rd, nil); err == nil {
+			t.Errorf("import ID %q should be rejected", bad)
+		}
+	}
+}
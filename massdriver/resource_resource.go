@@ -3,18 +3,19 @@ package massdriver
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"os"
 	"strings"
 
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/services/resources"
-	"github.com/xeipuuv/gojsonschema"
-	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -22,19 +23,12 @@ const (
 	defaultResourceSpecificationPath = "../massdriver.yaml"
 )
 
-// resourceArtifactSchema is the shape of the schema-artifacts.json file. It
-// contains JSON Schema fragments keyed by the resource's `field` name.
-type resourceArtifactSchema struct {
-	Properties map[string]any `json:"properties"`
-}
-
-// resourceBundleSpec is the shape of the relevant slice of massdriver.yaml.
-// We only look at `artifacts.<field>.$ref` to derive the resource type.
-type resourceBundleSpec struct {
-	Artifacts struct {
-		Properties map[string]map[string]string `yaml:"properties"`
-	} `yaml:"artifacts"`
-}
+// Values accepted by massdriver_resource's `validation_source` attribute.
+const (
+	validationSourceLocal  = "local"
+	validationSourceRemote = "remote"
+	validationSourceBoth   = "both"
+)
 
 func resourceResource() *schema.Resource {
 	return &schema.Resource{
@@ -44,6 +38,11 @@ func resourceResource() *schema.Resource {
 		ReadContext:   resourceResourceRead,
 		UpdateContext: resourceResourceUpdate,
 		DeleteContext: resourceResourceDelete,
+		CustomizeDiff: resourceResourceCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"field": {
@@ -58,29 +57,59 @@ func resourceResource() *schema.Resource {
 				Required:    true,
 			},
 			"resource_type": {
-				Description: "Resource type identifier (e.g., `aws-iam-role`). This attribute is computed from the `massdriver.yaml` specification.",
+				Description: "Resource type identifier (e.g., `aws-iam-role`). This attribute is computed from the `massdriver.yaml` specification during plan, so the type shows in the diff.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				ForceNew:    true,
 			},
 			"resource": {
-				Description: "JSON-encoded resource data. Validated locally against `schema-artifacts.json` (when present at `schema_path`) before being sent.",
+				Description:  "JSON-encoded resource data. Validated against the schema(s) selected by `validation_source` during plan when its value is known, and again before being sent. Stored in state; use `resource_wo` to keep the payload out of it. Left unset by `terraform import`, so the first apply after an import stores it. Exactly one of `resource` and `resource_wo` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"resource", "resource_wo"},
+			},
+			"resource_wo": {
+				Description:  "Write-only alternative to `resource`, requiring Terraform 1.11 or later. The payload is validated and sent the same way but never written to the plan or state; changes are detected through `payload_sha256`, which can't see values marked `$md.sensitive`, so change `resource_wo_version` along with them. Massdriver keeps the only copy of the payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"resource", "resource_wo"},
+			},
+			"resource_wo_version": {
+				Description: "Any value; changing it sends `resource_wo` again. Needed when only values marked `$md.sensitive` change, since those are masked before `payload_sha256` is computed.",
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Optional:    true,
+			},
+			"payload_sha256": {
+				Description: "SHA-256 of the payload Massdriver holds for the resource, in canonical JSON form (keys sorted, insignificant whitespace removed) with values marked `$md.sensitive` replaced by `[SENSITIVE]`, as the API masks them; refreshed on every read. The markers come from `schema_path`, or from the published resource type when `validation_source` is `remote`; when they can't be read, refresh keeps the stored digest and warns. A plan compares it with the digest of the configured `resource` or `resource_wo` and updates the resource when they differ, so changes made in the UI or API show as drift without the payload appearing in the plan. Reformatting a `resource_wo` payload plans nothing.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"schema_path": {
-				Description: "Path to the `schema-artifacts.json` JSON Schema file used for client-side validation. Defaults to `../schema-artifacts.json` (the location bundle scaffolding produces). Override only for local provider testing.",
+				Description: "Path to the `schema-artifacts.json` JSON Schema file used for client-side validation when `validation_source` is `local` or `both`. `$ref`s in it may point at `#/definitions/...`, at files relative to it, or at resource types (`massdriver/aws-vpc`, resolved from the provider's `resource_type_schema_dir`). Defaults to `../schema-artifacts.json` (the location bundle scaffolding produces). Override only for local provider testing.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     defaultResourceSchemaPath,
 			},
 			"specification_path": {
-				Description: "Path to `massdriver.yaml`, used to look up the resource type from `$ref` when `resource_type` is unset. Defaults to `../massdriver.yaml`. Override only for local provider testing.",
+				Description: "Path to `massdriver.yaml`, read on create for the field's `$ref` under `resources.properties` (or the legacy `artifacts.properties`) to determine `resource_type`. A version suffix on the `$ref` (`aws-vpc@1.2.0`) is ignored. Defaults to `../massdriver.yaml`. Override only for local provider testing.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     defaultResourceSpecificationPath,
 			},
+			"validation_source": {
+				Description: "Which JSON Schema the payload (`resource` or `resource_wo`) is validated against: `local` (the `schema_path` file), `remote` (the schema published in Massdriver for the resolved `resource_type`, fetched once per type for the provider run) or `both`. Defaults to `local`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     validationSourceLocal,
+				ValidateFunc: validation.StringInSlice([]string{
+					validationSourceLocal,
+					validationSourceRemote,
+					validationSourceBoth,
+				}, false),
+			},
 		},
 	}
 }
@@ -92,9 +121,9 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
-	resource, err := buildResource(d, pc.Client)
-	if err != nil {
-		return diag.FromErr(err)
+	resource, diags := buildResource(ctx, d, pc)
+	if diags.HasError() {
+		return diags
 	}
 
 	created, createErr := pc.ResourceService().CreateResource(ctx, resource)
@@ -104,7 +133,8 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	d.SetId(created.ID)
 	d.Set("resource_type", resource.Type)
-	return resourceResourceRead(ctx, d, meta)
+	diags = append(diags, setPayloadDigest(ctx, d, pc, resource.Type, resource.Payload)...)
+	return append(diags, resourceResourceRead(ctx, d, meta)...)
 }
 
 func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -126,6 +156,11 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set("field", got.Field)
 	d.Set("name", got.Name)
 	d.Set("resource_type", got.Type)
+	// A record without a payload says nothing about it, so the digest of
+	// the last payload sent is kept.
+	if got.Payload != nil {
+		return setPayloadDigest(ctx, d, pc, got.Type, got.Payload)
+	}
 	return nil
 }
 
@@ -136,9 +171,9 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 
-	resource, err := buildResource(d, pc.Client)
-	if err != nil {
-		return diag.FromErr(err)
+	resource, diags := buildResource(ctx, d, pc)
+	if diags.HasError() {
+		return diags
 	}
 
 	if _, updateErr := pc.ResourceService().UpdateResource(ctx, d.Id(), resource); updateErr != nil {
@@ -146,7 +181,8 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	d.Set("resource_type", resource.Type)
-	return resourceResourceRead(ctx, d, meta)
+	diags = append(diags, setPayloadDigest(ctx, d, pc, resource.Type, resource.Payload)...)
+	return append(diags, resourceResourceRead(ctx, d, meta)...)
 }
 
 func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -165,6 +201,41 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return nil
 }
 
+// resourceResourceImport adopts an existing provisioned resource by ID, e.g.
+// after a deployment's state file was lost. The deployment-scoped endpoint
+// returns the field, name, type and payload. Only the payload's digest is
+// kept: the importer can't tell whether the config uses `resource` or
+// `resource_wo`, and writing the payload into `resource` would put secrets in
+// state for a write-only config. A `resource_wo` config producing the same
+// payload plans nothing; a `resource` config plans an update that stores it.
+// The local path attributes are set to their defaults since they live only in
+// config.
+func resourceResourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	pc := meta.(*ProviderClient)
+
+	if err := requireDeploymentAuth(pc.Client); err != nil {
+		return nil, err
+	}
+
+	got, err := pc.ResourceService().GetResource(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unable to import resource %s: %w", d.Id(), err)
+	}
+
+	d.Set("field", got.Field)
+	d.Set("name", got.Name)
+	d.Set("resource_type", got.Type)
+	d.Set("schema_path", defaultResourceSchemaPath)
+	d.Set("specification_path", defaultResourceSpecificationPath)
+	d.Set("validation_source", validationSourceLocal)
+	// Terraform reads the resource right after importing it, and that read
+	// reports a digest that can't be computed.
+	if got.Payload != nil {
+		setPayloadDigest(ctx, d, pc, got.Type, got.Payload)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 // requireDeploymentAuth fast-fails with a clear error when the caller isn't
 // running inside a Massdriver deployment. The endpoint backing massdriver_resource
 // only accepts deployment-scoped credentials — checking up front gives a
@@ -177,23 +248,25 @@ func requireDeploymentAuth(mdClient *client.Client) error {
 }
 
 // buildResource constructs the SDK Resource from terraform state, including
-// schema validation, type lookup, and payload parsing.
-func buildResource(d *schema.ResourceData, mdClient *client.Client) (*resources.Resource, error) {
+// schema validation, type lookup, and payload parsing. Schema violations are
+// returned as one diagnostic each, attached to whichever of `resource` and
+// `resource_wo` holds the payload.
+func buildResource(ctx context.Context, d *schema.ResourceData, pc *ProviderClient) (*resources.Resource, diag.Diagnostics) {
 	field := d.Get("field").(string)
-	resourceJSON := d.Get("resource").(string)
 
-	if err := validateResourceJSON(field, resourceJSON, d.Get("schema_path").(string)); err != nil {
-		return nil, err
+	resourceType, diags := resolveResourceType(d, pc.Client)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	resourceType, err := resolveResourceType(d, mdClient)
-	if err != nil {
-		return nil, err
+	attr, resourceJSON := "resource", d.Get("resource").(string)
+	if wo, ok, _ := writeOnlyValue(d.GetRawConfig(), "resource_wo"); ok {
+		attr, resourceJSON = "resource_wo", wo
 	}
-
-	var payload map[string]any
-	if err := json.Unmarshal([]byte(resourceJSON), &payload); err != nil {
-		return nil, fmt.Errorf("invalid JSON in `resource`: %w", err)
+	payload, payloadDiags := checkResourcePayload(ctx, pc, attr, field, resourceJSON, d.Get("schema_path").(string), d.Get("validation_source").(string), resourceType)
+	diags = append(diags, payloadDiags...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	return &resources.Resource{
@@ -201,84 +274,220 @@ func buildResource(d *schema.ResourceData, mdClient *client.Client) (*resources.
 		Name:    d.Get("name").(string),
 		Type:    resourceType,
 		Payload: payload,
-	}, nil
+	}, diags
 }
 
-// validateResourceJSON runs the user's `resource` JSON against the JSON Schema
-// extracted from schema-artifacts.json under `properties.<field>`. Mirrors the
-// behavior of the deprecated `massdriver_artifact` resource.
-func validateResourceJSON(field, resourceJSON, schemaPath string) error {
-	if schemaPath == "" {
-		schemaPath = defaultResourceSchemaPath
+// checkResourcePayload validates resourceJSON, the value of attribute attr,
+// against the schema(s) selected by source and parses it. It is shared by
+// apply and plan so both report the same errors.
+func checkResourcePayload(ctx context.Context, pc *ProviderClient, attr, field, resourceJSON, schemaPath, source, resourceType string) (map[string]any, diag.Diagnostics) {
+	if source != validationSourceRemote {
+		if diags := validateResourceJSON(attr, field, resourceJSON, schemaPath, pc.typeRefs); diags.HasError() {
+			return nil, diags
+		}
 	}
 
-	schemaBytes, err := os.ReadFile(schemaPath)
-	if err != nil {
-		return fmt.Errorf("unable to open schema file: %s", schemaPath)
+	if source == validationSourceRemote || source == validationSourceBoth {
+		if diags := validateResourceJSONRemote(ctx, pc, attr, resourceType, resourceJSON); diags.HasError() {
+			return nil, diags
+		}
 	}
 
-	var schemaObj resourceArtifactSchema
-	if err := json.Unmarshal(schemaBytes, &schemaObj); err != nil {
-		return fmt.Errorf("invalid JSON in %s: %w", schemaPath, err)
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(resourceJSON), &payload); err != nil {
+		return nil, diag.Errorf("invalid JSON in `%s`: %s", attr, err)
 	}
+	return payload, nil
+}
 
-	specificSchema, exists := schemaObj.Properties[field]
-	if !exists {
-		return fmt.Errorf(`resource validation failed: field %q does not exist in schema`, field)
+// writeOnlyValue returns the write-only string attribute attr (e.g.
+// `resource_wo`) from the raw configuration. ok reports whether it is set,
+// and known whether its value is available yet. Write-only values are left
+// out of the plan and state, so Get never sees them.
+func writeOnlyValue(rawConfig cty.Value, attr string) (value string, ok, known bool) {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return "", false, true
 	}
+	v := rawConfig.GetAttr(attr)
+	switch {
+	case !v.IsKnown():
+		return "", true, false
+	case v.IsNull():
+		return "", false, true
+	}
+	return v.AsString(), true, true
+}
 
-	sl := gojsonschema.NewGoLoader(specificSchema.(map[string]any))
-	dl := gojsonschema.NewStringLoader(resourceJSON)
-
-	result, err := gojsonschema.Validate(sl, dl)
+// maskedPayloadDigest is the `payload_sha256` of a payload for field. The
+// API masks `$md.sensitive` values in the payloads it returns, so they are
+// masked the same way here, in the configured payload as well as the
+// returned one, and both sides hash alike. The markers come from the
+// bundle's schema at schemaPath unless source is remote, which has only the
+// schema published for resourceType.
+func maskedPayloadDigest(ctx context.Context, pc *ProviderClient, field, schemaPath, source, resourceType string, payload map[string]any) (string, error) {
+	if source != validationSourceRemote {
+		if schemaPath == "" {
+			schemaPath = defaultResourceSchemaPath
+		}
+		return maskedBundleDigest(field, schemaPath, pc.typeRefs, payload)
+	}
+	typeID := publishedTypeID(resourceType)
+	typeSchema, err := pc.ResourceTypeSchema(ctx, typeID)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("unable to fetch the published schema for resource type %s: %w", typeID, err)
 	}
-	if !result.Valid() {
-		return errors.New("resource validation failed: " + result.Errors()[0].String())
+	return canonicalJSONHash(maskSensitive(schemaWalker{root: typeSchema}, typeSchema, payload))
+}
+
+// setPayloadDigest stores the digest of payload in `payload_sha256`. When
+// the sensitive values can't be told apart, the stored digest is kept and a
+// warning explains that drift goes undetected until they can.
+func setPayloadDigest(ctx context.Context, d *schema.ResourceData, pc *ProviderClient, resourceType string, payload map[string]any) diag.Diagnostics {
+	digest, err := maskedPayloadDigest(ctx, pc, d.Get("field").(string), d.Get("schema_path").(string), d.Get("validation_source").(string), resourceType, payload)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "payload_sha256 was not refreshed",
+			Detail:   fmt.Sprintf("The payload's sensitive values could not be masked, so changes made outside Terraform are not detected: %s", err),
+		}}
 	}
+	d.Set("payload_sha256", digest)
 	return nil
 }
 
-// resolveResourceType returns the resource type to send to the API.
+// resourceResourceCustomizeDiff runs the apply-time checks during plan, so a
+// bad payload fails `terraform plan` instead of part-way through an apply,
+// and fills in `resource_type` so the planned type shows in the diff. Values
+// that are unknown until apply (e.g. a payload built from resources created
+// in the same run) are left for Create/Update to check.
 //
-// If `resource_type` is set in state (either explicitly by the user or
-// computed from a previous apply) we use it verbatim. Otherwise — only on
-// the first apply, before the field has been computed — we fall back to
-// reading `artifacts.<field>.$ref` from massdriver.yaml. Bare type IDs (no
-// slash) are prefixed with the org ID, matching the legacy artifact behavior.
-func resolveResourceType(d *schema.ResourceData, mdClient *client.Client) (string, error) {
-	if existing := d.Get("resource_type").(string); existing != "" {
-		return prefixOrgIfNeeded(existing, mdClient.Config.OrganizationID), nil
+// The payload is compared by digest: `payload_sha256` holds the digest of
+// what Massdriver has, and one that differs from the configured payload's
+// plans an update. That catches both a new `resource_wo`, which never
+// appears in the diff, and edits made outside Terraform. Massdriver masks
+// `$md.sensitive` values when it returns a payload, so both sides are hashed
+// with those values masked; a change to them alone is only sent when
+// `resource_wo_version` changes.
+func resourceResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	pc := meta.(*ProviderClient)
+	attr, resourceJSON, known := "resource", d.Get("resource").(string), d.NewValueKnown("resource")
+	if wo, ok, woKnown := writeOnlyValue(d.GetRawConfig(), "resource_wo"); ok {
+		attr, resourceJSON, known = "resource_wo", wo, woKnown
 	}
 
-	field := d.Get("field").(string)
-	specPath := d.Get("specification_path").(string)
-	if specPath == "" {
-		specPath = defaultResourceSpecificationPath
+	// An existing resource keeps the type it was created with unless field
+	// changes, which replaces it.
+	resourceType := d.Get("resource_type").(string)
+	if d.Id() == "" || d.HasChange("field") {
+		resourceType = ""
+		if d.NewValueKnown("field") && d.NewValueKnown("specification_path") {
+			ref, diags := resourceTypeFromSpec(d.Get("field").(string), d.Get("specification_path").(string))
+			if diags.HasError() {
+				return diagnosticsError(diags)
+			}
+			// CustomizeDiff can't return warnings; Create repeats the lookup
+			// and reports them there.
+			for _, w := range diags {
+				tflog.Warn(ctx, w.Summary, map[string]any{"detail": w.Detail})
+			}
+			resourceType = prefixOrgIfNeeded(ref, pc.Client.Config.OrganizationID)
+			if err := d.SetNew("resource_type", resourceType); err != nil {
+				return err
+			}
+		}
 	}
 
-	specBytes, err := os.ReadFile(specPath)
-	if err != nil {
-		return "", fmt.Errorf("unable to open specification file: %s", specPath)
+	settingsKnown := d.NewValueKnown("field") && d.NewValueKnown("schema_path") && d.NewValueKnown("validation_source")
+	if !known || resourceType == "" || !settingsKnown {
+		if err := d.SetNewComputed("payload_sha256"); err != nil {
+			return err
+		}
+	} else {
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(resourceJSON), &payload); err != nil {
+			return fmt.Errorf("invalid JSON in `%s`: %w", attr, err)
+		}
+		digest, err := maskedPayloadDigest(ctx, pc, d.Get("field").(string), d.Get("schema_path").(string), d.Get("validation_source").(string), resourceType, payload)
+		switch {
+		case err != nil:
+			// Read has warned about this; without a digest to compare,
+			// only changes to the configuration plan an update.
+			tflog.Warn(ctx, "unable to compare payload_sha256", map[string]any{"error": err.Error()})
+		case digest != d.Get("payload_sha256").(string):
+			if err := d.SetNew("payload_sha256", digest); err != nil {
+				return err
+			}
+		}
 	}
 
-	var spec resourceBundleSpec
-	if err := yaml.Unmarshal(specBytes, &spec); err != nil {
-		return "", fmt.Errorf("invalid YAML in %s: %w", specPath, err)
+	if d.Id() != "" && !d.HasChanges("field", "name", "resource", "resource_wo_version", "payload_sha256", "schema_path", "specification_path", "validation_source") {
+		return nil
+	}
+	if !known || resourceType == "" || !settingsKnown {
+		return nil
 	}
+	_, diags := checkResourcePayload(ctx, pc, attr, d.Get("field").(string), resourceJSON, d.Get("schema_path").(string), d.Get("validation_source").(string), resourceType)
+	return diagnosticsError(diags)
+}
 
-	artifactSpec, exists := spec.Artifacts.Properties[field]
-	if !exists {
-		return "", fmt.Errorf(`field %q does not exist in %s`, field, specPath)
+// validateResourceJSON runs the user's payload JSON against the JSON Schema
+// extracted from schema-artifacts.json under `properties.<field>`, reporting
+// violations against attr. Mirrors the behavior of the deprecated
+// `massdriver_artifact` resource.
+func validateResourceJSON(attr, field, resourceJSON, schemaPath string, types bundle.RefResolver) diag.Diagnostics {
+	if schemaPath == "" {
+		schemaPath = defaultResourceSchemaPath
 	}
+	return validateBundleField("resource validation failed", field, resourceJSON, schemaPath, types, cty.GetAttrPath(attr))
+}
 
-	ref, exists := artifactSpec["$ref"]
-	if !exists {
-		return "", fmt.Errorf(`field %q in %s has no $ref`, field, specPath)
+// validateResourceJSONRemote runs the payload JSON against the schema
+// Massdriver has published for the resource type. resourceType is the
+// org-qualified reference from resolveResourceType; the GraphQL API knows the
+// type by the part after the slash.
+func validateResourceJSONRemote(ctx context.Context, pc *ProviderClient, attr, resourceType, resourceJSON string) diag.Diagnostics {
+	typeID := publishedTypeID(resourceType)
+	typeSchema, err := pc.ResourceTypeSchema(ctx, typeID)
+	if err != nil {
+		return diag.Errorf("unable to fetch the published schema for resource type %s: %s", typeID, err)
+	}
+	if len(typeSchema) == 0 {
+		return nil
 	}
+	return validateAgainstSchema(fmt.Sprintf("resource validation failed (published %s schema)", typeID), typeSchema, resourceJSON, cty.GetAttrPath(attr))
+}
+
+// publishedTypeID is the ID the GraphQL API knows an org-qualified resource
+// type reference by: the part after the slash.
+func publishedTypeID(resourceType string) string {
+	return resourceType[strings.LastIndex(resourceType, "/")+1:]
+}
 
-	return prefixOrgIfNeeded(ref, mdClient.Config.OrganizationID), nil
+// resolveResourceType returns the resource type to send to the API.
+//
+// On create the type is always read from `$ref` in massdriver.yaml, so any
+// warnings about the spec reach the user. After that `resource_type` is set
+// in state and used verbatim. Bare type IDs (no slash) are prefixed with the
+// org ID, matching the legacy artifact behavior.
+func resolveResourceType(d *schema.ResourceData, mdClient *client.Client) (string, diag.Diagnostics) {
+	if existing := d.Get("resource_type").(string); d.Id() != "" && existing != "" {
+		return prefixOrgIfNeeded(existing, mdClient.Config.OrganizationID), nil
+	}
+
+	ref, diags := resourceTypeFromSpec(d.Get("field").(string), d.Get("specification_path").(string))
+	if diags.HasError() {
+		return "", diags
+	}
+	return prefixOrgIfNeeded(ref, mdClient.Config.OrganizationID), diags
+}
+
+// resourceTypeFromSpec returns the `$ref` declared for field in the bundle's
+// massdriver.yaml at specPath (not org-prefixed).
+func resourceTypeFromSpec(field, specPath string) (string, diag.Diagnostics) {
+	if specPath == "" {
+		specPath = defaultResourceSpecificationPath
+	}
+	return bundleResourceRef(field, specPath)
 }
 
 // prefixOrgIfNeeded matches the legacy artifact behavior: a bare type ID like
@@ -1,6 +1,8 @@
 package massdriver
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -11,9 +13,12 @@ import (
 	"testing"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"terraform-provider-massdriver/internal/gqlmock"
 )
 
 // recordedRequest captures one HTTP exchange so tests can assert on what the
@@ -56,6 +61,9 @@ func newRESTMockProvider(t *testing.T, handler http.HandlerFunc) (*ProviderClien
 				// test client has to look like it ran inside a bundle deployment.
 				Credentials: &config.Credentials{Method: config.AuthDeployment},
 			},
+			// payload_sha256 masks values by the published type schema;
+			// by default no type marks anything sensitive.
+			GQLv2: gqlmock.NewClientWithResponses(publishedSchema(map[string]any{})),
 			HTTP: resty.New().
 				SetBaseURL(srv.URL).
 				SetHeader("Content-Type", "application/json").
@@ -92,6 +100,19 @@ func writeBundleFiles(t *testing.T, field, ref string, fieldSchema map[string]an
 	return specPath, schemaPath
 }
 
+// chdirIntoBundle runs the rest of the test from a directory next to
+// schemaPath, so the default `../schema-artifacts.json` and
+// `../massdriver.yaml` paths find the files writeBundleFiles wrote, as they
+// do in a bundle's `src` directory.
+func chdirIntoBundle(t *testing.T, schemaPath string) {
+	t.Helper()
+	src := filepath.Join(filepath.Dir(schemaPath), "src")
+	if err := os.Mkdir(src, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(src)
+}
+
 // objectSchema is a permissive "anything goes as long as it's an object" JSON
 // Schema — useful for tests that don't care about field-level validation.
 func objectSchema() map[string]any {
@@ -181,6 +202,39 @@ func TestResourceResourceCreateFullyQualifiedRefPassesThrough(t *testing.T) {
 	}
 }
 
+// A field declared under both `resources:` and `artifacts:` uses the
+// `resources` type and the apply carries a warning about the duplicate.
+func TestResourceResourceCreateWarnsOnDuplicateSpecDeclaration(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "vpc"})
+	})
+	_, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+	specPath := filepath.Join(t.TempDir(), "massdriver.yaml")
+	specYAML := "resources:\n  properties:\n    vpc:\n      $ref: aws-vpc@2.0.0\nartifacts:\n  properties:\n    vpc:\n      $ref: aws-legacy-vpc\n"
+	if err := os.WriteFile(specPath, []byte(specYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{
+		"field":              "vpc",
+		"name":               "My VPC",
+		"resource":           `{"k":"v"}`,
+		"specification_path": specPath,
+		"schema_path":        schemaPath,
+	})
+
+	diags := resourceResourceCreate(t.Context(), rd, pc)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "both `resources` and `artifacts`") {
+		t.Errorf("expected a duplicate-declaration warning, got %v", diags)
+	}
+	if got := (*reqs)[0].Body["type"]; got != testOrgID+"/aws-vpc" {
+		t.Errorf("got body.type %v, want %s/aws-vpc", got, testOrgID)
+	}
+}
+
 // Schema validation runs *before* the API call — bad payloads must not reach
 // the server.
 func TestResourceResourceCreateRejectsInvalidPayloadAgainstSchema(t *testing.T) {
@@ -245,14 +299,16 @@ func TestResourceResourceCreateRejectsUnknownField(t *testing.T) {
 func TestResourceResourceRead(t *testing.T) {
 	pc, _ := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(map[string]any{
-			"id":    "res-1",
-			"field": "vpc",
-			"name":  "Server-side Name",
-			"type":  testOrgID + "/aws-vpc",
+			"id":      "res-1",
+			"field":   "vpc",
+			"name":    "Server-side Name",
+			"type":    testOrgID + "/aws-vpc",
+			"payload": map[string]any{"id": "vpc-123", "cidr": "10.0.0.0/16"},
 		})
 	})
+	_, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
 
-	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{})
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{"schema_path": schemaPath})
 	rd.SetId("res-1")
 
 	if diags := resourceResourceRead(t.Context(), rd, pc); diags.HasError() {
@@ -267,6 +323,9 @@ func TestResourceResourceRead(t *testing.T) {
 	if rd.Get("resource_type").(string) != testOrgID+"/aws-vpc" {
 		t.Errorf("got resource_type %q", rd.Get("resource_type"))
 	}
+	if want := sha256Hex(`{"cidr":"10.0.0.0/16","id":"vpc-123"}`); rd.Get("payload_sha256") != want {
+		t.Errorf("got payload_sha256 %v, want the digest of the server's payload %s", rd.Get("payload_sha256"), want)
+	}
 }
 
 // A 404 from the REST API means the resource was deleted out of band — Read
@@ -405,8 +464,11 @@ func TestResourceResourceSchema(t *testing.T) {
 	if rt := r.Schema["resource_type"]; rt == nil || rt.Required || rt.Optional || !rt.Computed || !rt.ForceNew {
 		t.Error("resource_type should be Computed+ForceNew (derived from massdriver.yaml, not user-supplied)")
 	}
-	if res := r.Schema["resource"]; res == nil || !res.Required || !res.Sensitive {
-		t.Error("resource should be Required+Sensitive")
+	if res := r.Schema["resource"]; res == nil || !res.Optional || !res.Sensitive || len(res.ExactlyOneOf) != 2 {
+		t.Error("resource should be Optional+Sensitive, exactly one of resource and resource_wo")
+	}
+	if wo := r.Schema["resource_wo"]; wo == nil || !wo.WriteOnly || !wo.Sensitive || len(wo.ExactlyOneOf) != 2 {
+		t.Error("resource_wo should be WriteOnly+Sensitive, exactly one of resource and resource_wo")
 	}
 	// schema_path / specification_path default to the bundle scaffolding's standard locations.
 	if sp := r.Schema["schema_path"]; sp.Default != defaultResourceSchemaPath {
@@ -416,3 +478,628 @@ func TestResourceResourceSchema(t *testing.T) {
 		t.Errorf("got specification_path default %v, want %s", sp.Default, defaultResourceSpecificationPath)
 	}
 }
+
+// Import must resolve everything the config would otherwise supply so the
+// first plan after adopting a resource is clean.
+func TestResourceResourceImport(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "res-1",
+			"field":   "vpc",
+			"name":    "Production VPC",
+			"type":    testOrgID + "/aws-vpc",
+			"payload": map[string]any{"data": map[string]any{"id": "vpc-123"}},
+		})
+	})
+	_, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+	chdirIntoBundle(t, schemaPath)
+
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{})
+	rd.SetId("res-1")
+
+	imported, err := resourceResource().Importer.StateContext(t.Context(), rd, pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("got %d imported states, want 1", len(imported))
+	}
+	if (*reqs)[0].Method != http.MethodGet || (*reqs)[0].Path != "/v1/resources/res-1" {
+		t.Errorf("got %s %s", (*reqs)[0].Method, (*reqs)[0].Path)
+	}
+	if rd.Get("field") != "vpc" || rd.Get("name") != "Production VPC" || rd.Get("resource_type") != testOrgID+"/aws-vpc" {
+		t.Errorf("got field=%v name=%v resource_type=%v", rd.Get("field"), rd.Get("name"), rd.Get("resource_type"))
+	}
+	if got := rd.Get("resource").(string); got != "" {
+		t.Errorf("the payload must not be imported into resource, got %s", got)
+	}
+	if got, want := rd.Get("payload_sha256"), sha256Hex(`{"data":{"id":"vpc-123"}}`); got != want {
+		t.Errorf("got payload_sha256 %v, want %s", got, want)
+	}
+	if rd.Get("schema_path") != defaultResourceSchemaPath || rd.Get("specification_path") != defaultResourceSpecificationPath {
+		t.Errorf("path attributes should be set to their defaults, got %v / %v", rd.Get("schema_path"), rd.Get("specification_path"))
+	}
+}
+
+// Importing a resource managed with `resource_wo` keeps the payload out of
+// state, and a config producing the payload Massdriver holds plans no update
+// even though the API returns its sensitive values masked.
+func TestResourceResourceImportWriteOnly(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      "res-1",
+			"field":   "db",
+			"name":    "Database",
+			"type":    testOrgID + "/postgres",
+			"payload": map[string]any{"data": map[string]any{"username": "admin", "password": "[SENSITIVE]"}},
+		})
+	})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	chdirIntoBundle(t, schemaPath)
+	server := newSDKServer(pc)
+
+	imported, diags := importResource(t, server, "massdriver_resource", "res-1")
+	requireNoDiagnostics(t, diags)
+	refreshed, diags := readResource(t, server, "massdriver_resource", imported)
+	requireNoDiagnostics(t, diags)
+
+	config := writeOnlyConfig(t, specPath, schemaPath, `{"data":{"username":"admin","password":"correct-horse-battery"}}`)
+	planned, diags := planResource(t, server, "massdriver_resource", refreshed, config)
+	requireNoDiagnostics(t, diags)
+
+	for _, state := range []string{imported, refreshed, planned} {
+		if strings.Contains(state, "correct-horse-battery") {
+			t.Fatalf("payload leaked into state: %s", state)
+		}
+		var got map[string]any
+		if err := json.Unmarshal([]byte(state), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got["resource"] != nil {
+			t.Errorf("resource should stay null, got %v", got["resource"])
+		}
+		if want := sha256Hex(`{"data":{"password":"[SENSITIVE]","username":"admin"}}`); got["payload_sha256"] != want {
+			t.Errorf("got payload_sha256 %v, want %s", got["payload_sha256"], want)
+		}
+	}
+	for _, req := range *reqs {
+		if req.Method != http.MethodGet {
+			t.Errorf("import and plan should only read, got %s %s", req.Method, req.Path)
+		}
+	}
+}
+
+func TestResourceResourceImportNotFound(t *testing.T) {
+	pc, _ := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{})
+	rd.SetId("gone")
+
+	_, err := resourceResource().Importer.StateContext(t.Context(), rd, pc)
+	if err == nil || !strings.Contains(err.Error(), "gone") {
+		t.Fatalf("expected an error naming the missing resource, got %v", err)
+	}
+}
+
+// publishedSchema is a getResourceType response publishing typeSchema.
+func publishedSchema(typeSchema map[string]any) map[string]map[string]any {
+	return map[string]map[string]any{
+		"getResourceType": {
+			"data": map[string]any{
+				"resourceType": map[string]any{
+					"id":     "aws-vpc",
+					"name":   "AWS VPC",
+					"schema": typeSchema,
+				},
+			},
+		},
+	}
+}
+
+// publishedVPCSchema is a getResourceType response whose schema requires
+// `data.id`, for the remote validation tests.
+func publishedVPCSchema() map[string]map[string]any {
+	return publishedSchema(map[string]any{
+		"type":     "object",
+		"required": []any{"data"},
+		"properties": map[string]any{
+			"data": map[string]any{
+				"type":       "object",
+				"required":   []any{"id"},
+				"properties": map[string]any{"id": map[string]any{"type": "string"}},
+			},
+		},
+	})
+}
+
+// With validation_source = "remote" the schema file is never opened, so a
+// deployment without schema-artifacts.json can still apply, and the payload is
+// checked against the published schema instead.
+func TestResourceResourceRemoteValidation(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "vpc", "type": testOrgID + "/aws-vpc"})
+	})
+	rec := gqlmock.NewClientWithResponses(publishedVPCSchema())
+	pc.Client.GQLv2 = rec
+	specPath, _ := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+
+	config := func(payload string) map[string]any {
+		return map[string]any{
+			"field":              "vpc",
+			"name":               "My VPC",
+			"resource":           payload,
+			"specification_path": specPath,
+			"schema_path":        filepath.Join(t.TempDir(), "missing.json"),
+			"validation_source":  "remote",
+		}
+	}
+
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, config(`{"data":{}}`))
+	diags := resourceResourceCreate(t.Context(), rd, pc)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "published aws-vpc schema") {
+		t.Fatalf("expected a remote validation error, got %v", diags)
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("invalid payloads must not reach the server, got %d requests", len(*reqs))
+	}
+
+	rd = schema.TestResourceDataRaw(t, resourceResource().Schema, config(`{"data":{"id":"vpc-123"}}`))
+	if diags := resourceResourceCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if vars := gqlmock.Variables(rec.FindRequest("getResourceType")); vars["id"] != "aws-vpc" {
+		t.Errorf("got resource type lookup %v, want aws-vpc without the org prefix", vars["id"])
+	}
+	if len(rec.Requests) != 1 {
+		t.Errorf("the published schema should be fetched once per provider, got %d lookups", len(rec.Requests))
+	}
+}
+
+// "both" applies the local schema and then the published one; either can
+// reject the payload.
+func TestResourceResourceBothValidation(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	pc.Client.GQLv2 = gqlmock.NewClientWithResponses(publishedVPCSchema())
+	specPath, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", objectSchema())
+
+	rd := schema.TestResourceDataRaw(t, resourceResource().Schema, map[string]any{
+		"field":              "vpc",
+		"name":               "My VPC",
+		"resource":           `{"data":{}}`,
+		"specification_path": specPath,
+		"schema_path":        schemaPath,
+		"validation_source":  "both",
+	})
+
+	diags := resourceResourceCreate(t.Context(), rd, pc)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "published aws-vpc schema") {
+		t.Fatalf("expected the published schema to reject the payload, got %v", diags)
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("invalid payloads must not reach the server, got %d requests", len(*reqs))
+	}
+}
+
+func TestResourceResourcePlan(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	specPath, schemaPath := writeBundleFiles(t, "vpc", "aws-vpc", map[string]any{
+		"type":     "object",
+		"required": []any{"id"},
+	})
+	existing := &terraform.InstanceState{
+		ID: "res-1",
+		Attributes: map[string]string{
+			"id":                 "res-1",
+			"field":              "vpc",
+			"name":               "My VPC",
+			"resource_type":      testOrgID + "/aws-vpc",
+			"resource":           `{"id":"vpc-123"}`,
+			"payload_sha256":     sha256Hex(`{"id":"vpc-123"}`),
+			"specification_path": specPath,
+			"schema_path":        schemaPath,
+			"validation_source":  "local",
+		},
+	}
+	// The same resource after its payload was edited in Massdriver.
+	edited := existing.DeepCopy()
+	edited.Attributes["payload_sha256"] = sha256Hex(`{"id":"vpc-456"}`)
+
+	tests := []struct {
+		name       string
+		state      *terraform.InstanceState
+		resource   string
+		wantErr    string
+		wantType   string
+		wantDigest string
+	}{
+		{name: "create", resource: `{"id":"vpc-123"}`, wantType: testOrgID + "/aws-vpc"},
+		{name: "create with invalid payload", resource: `{}`, wantErr: "resource validation failed at /id"},
+		{name: "create with payload unknown until apply", resource: unknownVariableValue, wantType: testOrgID + "/aws-vpc"},
+		{name: "update with invalid payload", state: existing, resource: `{"name":"vpc"}`, wantErr: "resource validation failed at /id"},
+		{name: "unchanged", state: existing, resource: `{"id":"vpc-123"}`},
+		{name: "payload changed outside Terraform", state: edited, resource: `{"id":"vpc-123"}`, wantDigest: sha256Hex(`{"id":"vpc-123"}`)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := terraform.NewResourceConfigRaw(map[string]any{
+				"field":              "vpc",
+				"name":               "My VPC",
+				"resource":           tc.resource,
+				"specification_path": specPath,
+				"schema_path":        schemaPath,
+			})
+			diff, err := resourceResource().Diff(t.Context(), tc.state, cfg, pc)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantDigest != "" {
+				if got := diff.Attributes["payload_sha256"]; got == nil || got.New != tc.wantDigest || diff.RequiresNew() {
+					t.Errorf("got planned payload_sha256 %#v, want an in-place update to %s", got, tc.wantDigest)
+				}
+				return
+			}
+			if tc.wantType == "" {
+				if diff != nil && !diff.Empty() {
+					t.Errorf("expected no changes, got %v", diff)
+				}
+				return
+			}
+			if got := diff.Attributes["resource_type"]; got == nil || got.New != tc.wantType || got.NewComputed {
+				t.Errorf("got planned resource_type %#v, want %q", got, tc.wantType)
+			}
+		})
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("planning must not call the API, got %d requests", len(*reqs))
+	}
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeOnlyConfig is a massdriver_resource configuration (state-file JSON)
+// that sends payload through `resource_wo`.
+func writeOnlyConfig(t *testing.T, specPath, schemaPath, payload string) string {
+	t.Helper()
+	b, err := json.Marshal(map[string]any{
+		"field":              "db",
+		"name":               "Database",
+		"resource_wo":        payload,
+		"specification_path": specPath,
+		"schema_path":        schemaPath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// A `resource_wo` payload is sent to the API but only the digest of its
+// masked form reaches state. Reformatting it plans nothing; changing it plans
+// an update that sends the new payload.
+func TestResourceResourceWriteOnly(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "db", "name": "Database", "type": testOrgID + "/postgres"})
+	})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	server := newSDKServer(pc)
+
+	attributes := func(state string) map[string]any {
+		var got map[string]any
+		if err := json.Unmarshal([]byte(state), &got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	created, diags := applyResource(t, server, "massdriver_resource", "",
+		writeOnlyConfig(t, specPath, schemaPath, `{"data": {"username": "admin", "password": "correct-horse-battery"}}`))
+	requireNoDiagnostics(t, diags)
+	if strings.Contains(created, "correct-horse-battery") {
+		t.Fatalf("payload leaked into state: %s", created)
+	}
+	got := attributes(created)
+	if want := sha256Hex(`{"data":{"password":"[SENSITIVE]","username":"admin"}}`); got["payload_sha256"] != want {
+		t.Errorf("got payload_sha256 %v, want %s", got["payload_sha256"], want)
+	}
+	if got["resource"] != nil || got["resource_wo"] != nil {
+		t.Errorf("state should hold neither payload attribute, got resource=%v resource_wo=%v", got["resource"], got["resource_wo"])
+	}
+	post := (*reqs)[0]
+	if post.Method != http.MethodPost {
+		t.Fatalf("got %s %s, want the create POST first", post.Method, post.Path)
+	}
+	if data, _ := post.Body["payload"].(map[string]any)["data"].(map[string]any); data["password"] != "correct-horse-battery" {
+		t.Errorf("the payload should still be sent in full, got %v", post.Body["payload"])
+	}
+
+	planned, diags := planResource(t, server, "massdriver_resource", created,
+		writeOnlyConfig(t, specPath, schemaPath, `{"data":{"password":"correct-horse-battery","username":"admin"}}`))
+	requireNoDiagnostics(t, diags)
+	if planned != created {
+		t.Errorf("reformatting the payload should plan no changes:\n got %s\nwant %s", planned, created)
+	}
+
+	*reqs = nil
+	updated, diags := applyResource(t, server, "massdriver_resource", created,
+		writeOnlyConfig(t, specPath, schemaPath, `{"data":{"username":"root","password":"correct-horse-battery"}}`))
+	requireNoDiagnostics(t, diags)
+	if want := sha256Hex(`{"data":{"password":"[SENSITIVE]","username":"root"}}`); attributes(updated)["payload_sha256"] != want {
+		t.Errorf("got payload_sha256 %v after the update, want %s", attributes(updated)["payload_sha256"], want)
+	}
+	if len(*reqs) == 0 || (*reqs)[0].Method != http.MethodPut {
+		t.Fatalf("a changed payload should update the resource, got %v", *reqs)
+	}
+	if data, _ := (*reqs)[0].Body["payload"].(map[string]any)["data"].(map[string]any); data["username"] != "root" || data["password"] != "correct-horse-battery" {
+		t.Errorf("got payload %v, want the new username with the password", (*reqs)[0].Body["payload"])
+	}
+}
+
+// Schema violations in a `resource_wo` payload fail the plan without
+// echoing sensitive values.
+func TestResourceResourceWriteOnlyValidatedAtPlan(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+
+	_, diags := applyResource(t, newSDKServer(pc), "massdriver_resource", "",
+		writeOnlyConfig(t, specPath, schemaPath, `{"data":{"username":"admin","password":"hunter2"}}`))
+	if !hasErrorDiagnostic(diags) {
+		t.Fatal("expected the plan to fail")
+	}
+	for _, d := range diags {
+		if !strings.HasPrefix(d.Summary, "resource validation failed at /data/password") {
+			t.Errorf("unexpected diagnostic %q", d.Summary)
+		}
+		if strings.Contains(d.Detail, "hunter2") {
+			t.Errorf("sensitive value leaked: %q", d.Detail)
+		}
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("planning must not call the API, got %d requests", len(*reqs))
+	}
+}
+
+func TestResourceResourceWriteOnlyConfigValidation(t *testing.T) {
+	pc, _ := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {})
+	server := newSDKServer(pc)
+	typ := resourceValueType(t, server, "massdriver_resource")
+
+	tests := []struct {
+		name      string
+		config    string
+		writeOnly bool
+		wantErr   string
+	}{
+		{name: "write-only", config: `{"field":"db","name":"Database","resource_wo":"{}"}`, writeOnly: true},
+		{name: "both payloads", config: `{"field":"db","name":"Database","resource":"{}","resource_wo":"{}"}`, writeOnly: true, wantErr: "only one of"},
+		{name: "no payload", config: `{"field":"db","name":"Database"}`, writeOnly: true, wantErr: "one of"},
+		{name: "terraform without write-only support", config: `{"field":"db","name":"Database","resource_wo":"{}"}`, wantErr: "Write-only"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := server.ValidateResourceTypeConfig(t.Context(), &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName:           "massdriver_resource",
+				Config:             dynamicValue(t, typ, tc.config),
+				ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{WriteOnlyAttributesAllowed: tc.writeOnly},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantErr == "" {
+				requireNoDiagnostics(t, resp.Diagnostics)
+				return
+			}
+			var summaries []string
+			for _, d := range resp.Diagnostics {
+				summaries = append(summaries, d.Summary+": "+d.Detail)
+			}
+			if got := strings.Join(summaries, "\n"); !strings.Contains(got, tc.wantErr) {
+				t.Errorf("got diagnostics %q, want one mentioning %q", got, tc.wantErr)
+			}
+		})
+	}
+}
+
+// maskedCopy masks the password the way the API does for credentialsSchema.
+func maskedCopy(payload map[string]any) map[string]any {
+	data, _ := payload["data"].(map[string]any)
+	masked := map[string]any{}
+	for k, v := range data {
+		masked[k] = v
+	}
+	if _, ok := masked["password"]; ok {
+		masked["password"] = "[SENSITIVE]"
+	}
+	return map[string]any{"data": masked}
+}
+
+// A payload edited in Massdriver shows up after refresh as a changed
+// payload_sha256, and the next apply sends the configured payload again. The
+// API masks `$md.sensitive` values, so both sides are hashed masked and an
+// unchanged config plans nothing. Only digests reach the plan and state.
+func TestResourceResourcePayloadDrift(t *testing.T) {
+	var stored map[string]any
+	var reqs *[]recordedRequest
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if payload, ok := (*reqs)[len(*reqs)-1].Body["payload"].(map[string]any); ok {
+			stored = payload
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "db", "name": "Database", "type": testOrgID + "/postgres", "payload": maskedCopy(stored)})
+	})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	server := newSDKServer(pc)
+
+	config := writeOnlyConfig(t, specPath, schemaPath, `{"data":{"username":"admin","password":"correct-horse-battery"}}`)
+	created, diags := applyResource(t, server, "massdriver_resource", "", config)
+	requireNoDiagnostics(t, diags)
+	if want := sha256Hex(`{"data":{"password":"[SENSITIVE]","username":"admin"}}`); !strings.Contains(created, `"payload_sha256":"`+want+`"`) {
+		t.Errorf("got state %s, want the masked payload's digest %s", created, want)
+	}
+
+	refreshed, diags := readResource(t, server, "massdriver_resource", created)
+	requireNoDiagnostics(t, diags)
+	if refreshed != created {
+		t.Fatalf("refresh without a server-side change altered state:\n got %s\nwant %s", refreshed, created)
+	}
+	plan := planResourceChange(t, server, "massdriver_resource", refreshed, config)
+	requireNoDiagnostics(t, plan.Diagnostics)
+	if planned := stateJSON(t, resourceValueType(t, server, "massdriver_resource"), plan.PlannedState); planned != refreshed {
+		t.Errorf("an unchanged config should plan nothing:\n got %s\nwant %s", planned, refreshed)
+	}
+
+	// Someone renames the user in the Massdriver UI.
+	stored = map[string]any{"data": map[string]any{"username": "root", "password": "correct-horse-battery"}}
+	refreshed, diags = readResource(t, server, "massdriver_resource", created)
+	requireNoDiagnostics(t, diags)
+	if refreshed == created {
+		t.Fatal("refresh should pick up the server-side change")
+	}
+	planned, diags := planResource(t, server, "massdriver_resource", refreshed, config)
+	requireNoDiagnostics(t, diags)
+	if planned != created {
+		t.Errorf("the plan should restore the configured payload's digest:\n got %s\nwant %s", planned, created)
+	}
+	for _, state := range []string{refreshed, planned} {
+		if strings.Contains(state, "correct-horse-battery") {
+			t.Fatalf("payload leaked: %s", state)
+		}
+	}
+
+	*reqs = nil
+	applied, diags := applyResource(t, server, "massdriver_resource", refreshed, config)
+	requireNoDiagnostics(t, diags)
+	if applied != created {
+		t.Errorf("got state %s after correcting the drift, want %s", applied, created)
+	}
+	if len(*reqs) == 0 || (*reqs)[0].Method != http.MethodPut {
+		t.Fatalf("the drift should be corrected with an update, got %v", *reqs)
+	}
+	if data, _ := stored["data"].(map[string]any); data["username"] != "admin" {
+		t.Errorf("got stored payload %v, want the configured one", stored)
+	}
+}
+
+// Sensitive values are masked before hashing, so changing only them in
+// `resource_wo` plans nothing until resource_wo_version changes too.
+func TestResourceResourceWriteOnlyVersion(t *testing.T) {
+	pc, reqs := newRESTMockProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "db", "name": "Database", "type": testOrgID + "/postgres"})
+	})
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+	server := newSDKServer(pc)
+
+	config := func(password, version string) string {
+		var cfg map[string]any
+		if err := json.Unmarshal([]byte(writeOnlyConfig(t, specPath, schemaPath, `{"data":{"username":"admin","password":"`+password+`"}}`)), &cfg); err != nil {
+			t.Fatal(err)
+		}
+		cfg["resource_wo_version"] = version
+		b, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	created, diags := applyResource(t, server, "massdriver_resource", "", config("correct-horse-battery", "1"))
+	requireNoDiagnostics(t, diags)
+
+	planned, diags := planResource(t, server, "massdriver_resource", created, config("battery-staple-horse", "1"))
+	requireNoDiagnostics(t, diags)
+	if planned != created {
+		t.Errorf("a masked change alone can't be seen and should plan nothing:\n got %s\nwant %s", planned, created)
+	}
+
+	*reqs = nil
+	_, diags = applyResource(t, server, "massdriver_resource", created, config("battery-staple-horse", "2"))
+	requireNoDiagnostics(t, diags)
+	if len(*reqs) == 0 || (*reqs)[0].Method != http.MethodPut {
+		t.Fatalf("a new resource_wo_version should update the resource, got %v", *reqs)
+	}
+	if data, _ := (*reqs)[0].Body["payload"].(map[string]any)["data"].(map[string]any); data["password"] != "battery-staple-horse" {
+		t.Errorf("got payload %v, want the new password", (*reqs)[0].Body["payload"])
+	}
+}
+
+// resourceTypeUnavailable is a getResourceType response failing the way a
+// token without access to resource types, or an outage, does.
+func resourceTypeUnavailable() map[string]map[string]any {
+	return map[string]map[string]any{
+		"getResourceType": {"errors": []any{map[string]any{"message": "forbidden"}}},
+	}
+}
+
+// Masking never needs the published schema when validation is local, and
+// when it is remote a failed fetch keeps the stored digest with a warning
+// rather than failing refresh and plan.
+func TestResourceResourcePublishedSchemaUnavailable(t *testing.T) {
+	payload := `{"data":{"username":"admin","password":"correct-horse-battery"}}`
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "res-1", "field": "db", "name": "Database", "type": testOrgID + "/postgres",
+			"payload": map[string]any{"data": map[string]any{"username": "admin", "password": "[SENSITIVE]"}}})
+	}
+	specPath, schemaPath := writeBundleFiles(t, "db", "postgres", credentialsSchema)
+
+	t.Run("local", func(t *testing.T) {
+		pc, _ := newRESTMockProvider(t, handler)
+		rec := gqlmock.NewClientWithResponses(resourceTypeUnavailable())
+		pc.Client.GQLv2 = rec
+		server := newSDKServer(pc)
+
+		config := writeOnlyConfig(t, specPath, schemaPath, payload)
+		created, diags := applyResource(t, server, "massdriver_resource", "", config)
+		requireNoDiagnostics(t, diags)
+		refreshed, diags := readResource(t, server, "massdriver_resource", created)
+		requireNoDiagnostics(t, diags)
+		planned, diags := planResource(t, server, "massdriver_resource", refreshed, config)
+		requireNoDiagnostics(t, diags)
+		if planned != created {
+			t.Errorf("an unchanged config should plan nothing:\n got %s\nwant %s", planned, created)
+		}
+		if req := rec.FindRequest("getResourceType"); req != nil {
+			t.Errorf("local validation should not fetch the published schema, got %v", gqlmock.Variables(req))
+		}
+	})
+
+	t.Run("remote", func(t *testing.T) {
+		var cfg map[string]any
+		if err := json.Unmarshal([]byte(writeOnlyConfig(t, specPath, schemaPath, payload)), &cfg); err != nil {
+			t.Fatal(err)
+		}
+		cfg["validation_source"] = validationSourceRemote
+		b, _ := json.Marshal(cfg)
+		config := string(b)
+
+		pc, _ := newRESTMockProvider(t, handler)
+		pc.Client.GQLv2 = gqlmock.NewClientWithResponses(publishedSchema(credentialsSchema))
+		created, diags := applyResource(t, newSDKServer(pc), "massdriver_resource", "", config)
+		requireNoDiagnostics(t, diags)
+
+		// A later run can no longer fetch the type.
+		pc, _ = newRESTMockProvider(t, handler)
+		pc.Client.GQLv2 = gqlmock.NewClientWithResponses(resourceTypeUnavailable())
+		server := newSDKServer(pc)
+		refreshed, diags := readResource(t, server, "massdriver_resource", created)
+		if len(diags) != 1 || diags[0].Severity != tfprotov5.DiagnosticSeverityWarning || !strings.Contains(diags[0].Detail, "forbidden") {
+			t.Fatalf("got diagnostics %v, want one warning about the failed fetch", diags)
+		}
+		if refreshed != created {
+			t.Errorf("the stored digest should be kept:\n got %s\nwant %s", refreshed, created)
+		}
+		planned, diags := planResource(t, server, "massdriver_resource", refreshed, config)
+		requireNoDiagnostics(t, diags)
+		if planned != created {
+			t.Errorf("an unchanged config should plan nothing:\n got %s\nwant %s", planned, created)
+		}
+	})
+}
@@ -0,0 +1,237 @@
+package massdriver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"terraform-provider-massdriver/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// resourceTypeNamePattern is the kebab-case rule the server applies to a
+// schema's `$md.name`.
+var resourceTypeNamePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func resourceResourceType() *schema.Resource {
+	return &schema.Resource{
+		Description: "Publishes a resource type (artifact definition) from a JSON Schema document. The type's ID comes from the schema's `$md.name`; changing it replaces the resource type. Changes made outside Terraform are detected by comparing a normalized hash of the published schema. Destroy is refused while resources of the type still exist.",
+
+		CreateContext: resourceResourceTypeCreate,
+		ReadContext:   resourceResourceTypeRead,
+		UpdateContext: resourceResourceTypeUpdate,
+		DeleteContext: resourceResourceTypeDelete,
+		CustomizeDiff: resourceResourceTypeCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"schema": {
+				Description:      "The JSON Schema document as a JSON string. Exactly one of `schema` and `schema_path` must be set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"schema", "schema_path"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"schema_path": {
+				Description: "Path to a file containing the JSON Schema document. Edits to the file are picked up through `schema_sha256`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"schema_sha256": {
+				Description: "SHA-256 of the normalized schema (keys sorted, insignificant whitespace removed). Compared against the published schema to detect drift.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resource_type_id": {
+				Description: "ID of the resource type, taken from the schema's `$md.name` (e.g. `aws-iam-role`).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Display name of the resource type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceResourceTypeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	doc, err := loadResourceTypeSchema(d.Get("schema").(string), d.Get("schema_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceType, err := api.PublishResourceType(ctx, client, doc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceType.ID)
+	return resourceResourceTypeRead(ctx, d, meta)
+}
+
+func resourceResourceTypeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	resourceType, err := api.GetResourceType(ctx, client, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	hash, err := canonicalJSONHash(resourceType.Schema)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("resource_type_id", resourceType.ID)
+	d.Set("name", resourceType.Name)
+	d.Set("schema_sha256", hash)
+	return nil
+}
+
+func resourceResourceTypeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	doc, err := loadResourceTypeSchema(d.Get("schema").(string), d.Get("schema_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := api.PublishResourceType(ctx, client, doc); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceResourceTypeRead(ctx, d, meta)
+}
+
+// resourceResourceTypeDelete refuses to delete a type that resources still
+// conform to, listing them, instead of relying on the server's rejection.
+func resourceResourceTypeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderClient).Client
+
+	resources, err := api.ListResources(ctx, client, &api.ResourcesFilter{
+		ResourceType: &api.StringFilter{Eq: d.Id()},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(resources) > 0 {
+		names := make([]string, 0, len(resources))
+		for _, r := range resources {
+			names = append(names, fmt.Sprintf("%s (%s)", r.Name, r.ID))
+		}
+		return diag.Errorf("resource type %s cannot be deleted while resources of this type exist:\n  - %s", d.Id(), strings.Join(names, "\n  - "))
+	}
+
+	if _, err := api.DeleteResourceType(ctx, client, d.Id()); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceResourceTypeCustomizeDiff loads and validates the schema at plan
+// time. A hash that differs from state (a local edit, including to the file
+// behind schema_path, or a change made outside Terraform) plans an update;
+// a new `$md.name` plans a replacement.
+func resourceResourceTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("schema") || !d.NewValueKnown("schema_path") {
+		return d.SetNewComputed("schema_sha256")
+	}
+
+	doc, err := loadResourceTypeSchema(d.Get("schema").(string), d.Get("schema_path").(string))
+	if err != nil {
+		return err
+	}
+	hash, err := canonicalJSONHash(doc)
+	if err != nil {
+		return err
+	}
+	if hash != d.Get("schema_sha256").(string) {
+		if err := d.SetNew("schema_sha256", hash); err != nil {
+			return err
+		}
+	}
+
+	typeID := resourceTypeNameOf(doc)
+	if old := d.Get("resource_type_id").(string); typeID != old {
+		if err := d.SetNew("resource_type_id", typeID); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("resource_type_id")
+		}
+	}
+	return nil
+}
+
+// loadResourceTypeSchema reads the schema from whichever of the inline JSON
+// or the file path is set, and checks that it is a usable resource type
+// definition.
+func loadResourceTypeSchema(schemaJSON, schemaPath string) (map[string]any, error) {
+	source := "`schema`"
+	if schemaJSON == "" {
+		b, err := os.ReadFile(schemaPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open schema file: %s", schemaPath)
+		}
+		schemaJSON, source = string(b), schemaPath
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(schemaJSON), &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON in %s: %w", source, err)
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc)); err != nil {
+		return nil, fmt.Errorf("%s is not a valid JSON Schema: %w", source, err)
+	}
+	name := resourceTypeNameOf(doc)
+	if name == "" {
+		return nil, fmt.Errorf("%s must set `$md.name` to the resource type's ID", source)
+	}
+	if !resourceTypeNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("%s: `$md.name` %q must be kebab-case (e.g. `aws-iam-role`)", source, name)
+	}
+	return doc, nil
+}
+
+func resourceTypeNameOf(doc map[string]any) string {
+	md, _ := doc["$md"].(map[string]any)
+	name, _ := md["name"].(string)
+	return name
+}
+
+// canonicalJSONHash hashes a document's canonical JSON encoding.
+// encoding/json sorts map keys, so documents that differ only in key order or
+// whitespace hash the same.
+func canonicalJSONHash(doc map[string]any) (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
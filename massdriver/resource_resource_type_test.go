@@ -0,0 +1,173 @@
+package massdriver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"terraform-provider-massdriver/internal/gqlmock"
+)
+
+const testResourceTypeSchema = `{
+  "$md": {"name": "aws-iam-role", "label": "AWS IAM Role"},
+  "type": "object",
+  "properties": {"data": {"type": "object", "required": ["arn"], "properties": {"arn": {"type": "string"}}}}
+}`
+
+func mustSchemaHash(t *testing.T, doc string) string {
+	t.Helper()
+	parsed, err := loadResourceTypeSchema(doc, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := canonicalJSONHash(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
+
+func TestSchemaHashIgnoresFormatting(t *testing.T) {
+	compact := `{"type":"object","$md":{"label":"AWS IAM Role","name":"aws-iam-role"},"properties":{"data":{"type":"object","required":["arn"],"properties":{"arn":{"type":"string"}}}}}`
+	if mustSchemaHash(t, compact) != mustSchemaHash(t, testResourceTypeSchema) {
+		t.Error("key order and whitespace must not change the hash")
+	}
+}
+
+func TestLoadResourceTypeSchemaRejectsInvalidDocuments(t *testing.T) {
+	tests := map[string]string{
+		"not a JSON Schema":  `{"$md": {"name": "aws-iam-role"}, "type": 12}`,
+		"missing $md.name":   `{"type": "object"}`,
+		"non-kebab $md.name": `{"$md": {"name": "AWS_IAM_Role"}, "type": "object"}`,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := loadResourceTypeSchema(doc, ""); err == nil {
+				t.Errorf("expected %s to be rejected", name)
+			}
+		})
+	}
+}
+
+func TestResourceResourceTypeCreateFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(path, []byte(testResourceTypeSchema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"publishResourceType": {
+			"data": map[string]any{
+				"publishResourceType": map[string]any{
+					"result":     map[string]any{"id": "aws-iam-role", "name": "AWS IAM Role"},
+					"successful": true,
+				},
+			},
+		},
+		"getResourceType": {
+			"data": map[string]any{
+				"resourceType": map[string]any{
+					"id":     "aws-iam-role",
+					"name":   "AWS IAM Role",
+					"schema": map[string]any{"$md": map[string]any{"name": "aws-iam-role"}},
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceResourceType().Schema, map[string]any{
+		"schema_path": path,
+	})
+
+	if diags := resourceResourceTypeCreate(t.Context(), rd, pc); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rd.Id() != "aws-iam-role" || rd.Get("name") != "AWS IAM Role" {
+		t.Errorf("got id=%q name=%v", rd.Id(), rd.Get("name"))
+	}
+	input := gqlmock.Variables(rec.FindRequest("publishResourceType"))["input"].(map[string]any)
+	if schemaArg, _ := input["schema"].(string); !strings.Contains(schemaArg, "aws-iam-role") {
+		t.Errorf("got schema %v", input["schema"])
+	}
+	// The hash in state reflects what the server has, so drift shows up.
+	if rd.Get("schema_sha256") == mustSchemaHash(t, testResourceTypeSchema) {
+		t.Error("schema_sha256 should be computed from the published schema")
+	}
+}
+
+func TestResourceResourceTypePlan(t *testing.T) {
+	state := func(hash string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "aws-iam-role",
+			Attributes: map[string]string{
+				"id":               "aws-iam-role",
+				"schema":           testResourceTypeSchema,
+				"schema_sha256":    hash,
+				"resource_type_id": "aws-iam-role",
+				"name":             "AWS IAM Role",
+			},
+		}
+	}
+	renamed := strings.Replace(testResourceTypeSchema, `"name": "aws-iam-role"`, `"name": "aws-iam-role-v2"`, 1)
+
+	tests := []struct {
+		name        string
+		schema      string
+		stateHash   string
+		wantChange  bool
+		wantReplace bool
+	}{
+		{name: "in sync", schema: testResourceTypeSchema, stateHash: mustSchemaHash(t, testResourceTypeSchema)},
+		{name: "changed outside terraform", schema: testResourceTypeSchema, stateHash: "stale", wantChange: true},
+		{name: "new $md.name", schema: renamed, stateHash: mustSchemaHash(t, testResourceTypeSchema), wantChange: true, wantReplace: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := terraform.NewResourceConfigRaw(map[string]any{"schema": tc.schema})
+			diff, err := resourceResourceType().Diff(t.Context(), state(tc.stateHash), cfg, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changed := diff != nil && !diff.Empty()
+			if changed != tc.wantChange {
+				t.Errorf("got change=%v, want %v (diff %v)", changed, tc.wantChange, diff)
+			}
+			if changed && diff.RequiresNew() != tc.wantReplace {
+				t.Errorf("got replace=%v, want %v", diff.RequiresNew(), tc.wantReplace)
+			}
+		})
+	}
+}
+
+func TestResourceResourceTypeDeleteBlockedByResources(t *testing.T) {
+	pc, rec := newMockProvider(map[string]map[string]any{
+		"listResources": {
+			"data": map[string]any{
+				"resources": map[string]any{
+					"cursor": map[string]any{"next": nil},
+					"items": []map[string]any{
+						{"id": "r-1", "name": "CI role", "origin": "IMPORTED", "resourceType": map[string]any{"id": "aws-iam-role"}},
+					},
+				},
+			},
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, resourceResourceType().Schema, map[string]any{})
+	rd.SetId("aws-iam-role")
+
+	diags := resourceResourceTypeDelete(t.Context(), rd, pc)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "CI role (r-1)") {
+		t.Fatalf("expected an error listing the blocking resource, got %v", diags)
+	}
+	if rec.FindRequest("deleteResourceType") != nil {
+		t.Error("deleteResourceType must not fire while resources of the type exist")
+	}
+	filter := gqlmock.Variables(rec.FindRequest("listResources"))["filter"].(map[string]any)
+	if filter["resourceType"].(map[string]any)["eq"] != "aws-iam-role" {
+		t.Errorf("got filter %v", filter)
+	}
+}
@@ -0,0 +1,311 @@
+package massdriver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// redactedValue stands in for values the schema marks `$md.sensitive`.
+const redactedValue = "(sensitive value redacted)"
+
+// maskedValue is what the API returns in place of values the resource type
+// marks `$md.sensitive`.
+const maskedValue = "[SENSITIVE]"
+
+// schemaKeywords maps gojsonschema's error types to the JSON Schema keyword
+// that produced them, which is what schema authors search for.
+var schemaKeywords = map[string]string{
+	"false":                           "false",
+	"required":                        "required",
+	"invalid_type":                    "type",
+	"number_any_of":                   "anyOf",
+	"number_one_of":                   "oneOf",
+	"number_all_of":                   "allOf",
+	"number_not":                      "not",
+	"missing_dependency":              "dependencies",
+	"const":                           "const",
+	"enum":                            "enum",
+	"array_no_additional_items":       "additionalItems",
+	"array_min_items":                 "minItems",
+	"array_max_items":                 "maxItems",
+	"unique":                          "uniqueItems",
+	"contains":                        "contains",
+	"array_min_properties":            "minProperties",
+	"array_max_properties":            "maxProperties",
+	"additional_property_not_allowed": "additionalProperties",
+	"invalid_property_pattern":        "patternProperties",
+	"invalid_property_name":           "propertyNames",
+	"string_gte":                      "minLength",
+	"string_lte":                      "maxLength",
+	"pattern":                         "pattern",
+	"format":                          "format",
+	"multiple_of":                     "multipleOf",
+	"number_gte":                      "minimum",
+	"number_gt":                       "exclusiveMinimum",
+	"number_lte":                      "maximum",
+	"number_lt":                       "exclusiveMaximum",
+	"condition_then":                  "then",
+	"condition_else":                  "else",
+}
+
+// validateAgainstSchema validates a JSON document against a JSON Schema and
+// returns one diagnostic per violation, so a payload with several mistakes
+// can be fixed in one pass. summary names what was being validated, e.g.
+// "resource validation failed"; attr is the attribute holding the document.
+// `#/...` references are resolved within jsonSchema.
+func validateAgainstSchema(summary string, jsonSchema map[string]any, document string, attr cty.Path) diag.Diagnostics {
+	// gojsonschema rewrites $refs in the map it is given, and jsonSchema may
+	// be shared through a cache, so it compiles from a serialized copy.
+	schemaJSON, err := json.Marshal(jsonSchema)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaJSON))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        err.Error(),
+			AttributePath: attr,
+		}}
+	}
+	return validateWithSchema(summary, compiled, schemaWalker{root: jsonSchema}, jsonSchema, document, attr)
+}
+
+// validateWithSchema runs an already compiled schema. node is the
+// uncompiled schema, used with walker to look up `$md.sensitive`.
+func validateWithSchema(summary string, compiled *gojsonschema.Schema, walker schemaWalker, node map[string]any, document string, attr cty.Path) diag.Diagnostics {
+	result, err := compiled.Validate(gojsonschema.NewStringLoader(document))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        err.Error(),
+			AttributePath: attr,
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, violation := range result.Errors() {
+		diags = append(diags, violationDiagnostic(summary, walker, node, violation, attr))
+	}
+	return diags
+}
+
+func violationDiagnostic(summary string, walker schemaWalker, node map[string]any, violation gojsonschema.ResultError, attr cty.Path) diag.Diagnostic {
+	segments := pointerSegments(violation.Context())
+	// required and additionalProperties are reported against the enclosing
+	// object; point at the member that is missing or unexpected instead.
+	if property, ok := violation.Details()["property"].(string); ok {
+		switch violation.Type() {
+		case "required", "additional_property_not_allowed":
+			segments = append(segments, property)
+		}
+	}
+	pointer := jsonPointer(segments)
+
+	location := pointer
+	if location == "" {
+		location = "(root)"
+	}
+
+	keyword, ok := schemaKeywords[violation.Type()]
+	if !ok {
+		keyword = violation.Type()
+	}
+
+	detail := fmt.Sprintf("%s\n\nJSON pointer: %s\nSchema keyword: %s", violation.Description(), location, keyword)
+	if value, ok := describeValue(violation.Value(), walker.isSensitive(node, segments)); ok {
+		detail += "\nValue: " + value
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary + " at " + location,
+		Detail:        detail,
+		AttributePath: attr,
+	}
+}
+
+// pointerSegments turns gojsonschema's context ("(root).data.password") into
+// path segments. The context is rendered with "/" so keys containing dots
+// survive.
+func pointerSegments(context *gojsonschema.JsonContext) []string {
+	if context == nil {
+		return nil
+	}
+	path := strings.TrimPrefix(context.String("/"), gojsonschema.STRING_CONTEXT_ROOT)
+	if path == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimPrefix(path, "/"), "/")
+}
+
+// jsonPointer renders segments as an RFC 6901 JSON pointer.
+func jsonPointer(segments []string) string {
+	var b strings.Builder
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	for _, s := range segments {
+		b.WriteString("/")
+		b.WriteString(escaper.Replace(s))
+	}
+	return b.String()
+}
+
+const maxRefHops = 32
+
+// schemaWalker follows a document path through a schema to find
+// `$md.sensitive` markers, dereferencing `$ref`s on the way: `#/...` pointers
+// against root, and resource type references through types when set.
+// References it can't follow (files, URLs) end the walk.
+type schemaWalker struct {
+	root  map[string]any
+	types func(ref string) map[string]any
+}
+
+// isSensitive reports whether the value at segments below node, or anything
+// enclosing it, is marked `$md.sensitive: true`. Paths the walk cannot follow
+// are treated as not sensitive.
+func (w schemaWalker) isSensitive(node map[string]any, segments []string) bool {
+	for i := 0; node != nil; i++ {
+		// Follow chains of $ref; the hop limit guards against cycles.
+		for hops := 0; ; hops++ {
+			if isMarkedSensitive(node) {
+				return true
+			}
+			ref, ok := node["$ref"].(string)
+			if !ok || hops == maxRefHops {
+				break
+			}
+			target, next := w.deref(ref)
+			if target == nil {
+				break
+			}
+			node, w = target, next
+		}
+		if i == len(segments) {
+			return false
+		}
+		node = childSchema(node, segments[i])
+	}
+	return false
+}
+
+// deref returns the schema ref points at and the walker to continue with,
+// since pointers inside a resource type resolve against that type.
+func (w schemaWalker) deref(ref string) (map[string]any, schemaWalker) {
+	if fragment, ok := strings.CutPrefix(ref, "#"); ok {
+		return bundle.ResolvePointer(w.root, fragment), w
+	}
+	if w.types != nil && bundle.IsTypeRef(ref) {
+		if target := w.types(ref); target != nil {
+			return target, schemaWalker{root: target, types: w.types}
+		}
+	}
+	return nil, w
+}
+
+func isMarkedSensitive(node map[string]any) bool {
+	md, ok := node["$md"].(map[string]any)
+	return ok && md["sensitive"] == true
+}
+
+// childSchema returns the subschema that applies to the given object key or
+// array index of a value matching node.
+func childSchema(node map[string]any, segment string) map[string]any {
+	if properties, ok := node["properties"].(map[string]any); ok {
+		if child, ok := properties[segment].(map[string]any); ok {
+			return child
+		}
+	}
+	if index, err := strconv.Atoi(segment); err == nil {
+		switch items := node["items"].(type) {
+		case map[string]any:
+			return items
+		case []any:
+			if index < len(items) {
+				child, _ := items[index].(map[string]any)
+				return child
+			}
+		}
+	}
+	if additional, ok := node["additionalProperties"].(map[string]any); ok {
+		return additional
+	}
+	return nil
+}
+
+// maskSensitive returns a copy of payload with every value node marks
+// `$md.sensitive` replaced by maskedValue, as the API masks payloads it
+// returns. Masking an already masked payload leaves it unchanged.
+func maskSensitive(walker schemaWalker, node map[string]any, payload map[string]any) map[string]any {
+	masked, _ := maskValue(walker, node, payload, nil).(map[string]any)
+	return masked
+}
+
+func maskValue(walker schemaWalker, node map[string]any, value any, segments []string) any {
+	if len(segments) > 0 && walker.isSensitive(node, segments) {
+		return maskedValue
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, child := range v {
+			out[k] = maskValue(walker, node, child, append(segments[:len(segments):len(segments)], k))
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = maskValue(walker, node, child, append(segments[:len(segments):len(segments)], strconv.Itoa(i)))
+		}
+		return out
+	}
+	return value
+}
+
+// describeValue formats the offending value for a diagnostic. Only scalars
+// are shown; objects and arrays would repeat most of the payload.
+func describeValue(value any, sensitive bool) (string, bool) {
+	switch v := value.(type) {
+	case map[string]any, []any:
+		return "", false
+	case nil:
+		return "null", true
+	default:
+		if sensitive {
+			return redactedValue, true
+		}
+		if s, ok := v.(string); ok {
+			return strconv.Quote(s), true
+		}
+		return fmt.Sprint(v), true
+	}
+}
+
+// diagnosticsError folds error diagnostics into a single error for callers
+// such as CustomizeDiff that can only return one. Each violation keeps its
+// own line; the attribute path is lost.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail == "" {
+			errs = append(errs, errors.New(d.Summary))
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary, strings.ReplaceAll(d.Detail, "\n\n", "\n")))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,155 @@
+package massdriver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-massdriver/internal/bundle"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+var credentialsSchema = map[string]any{
+	"type":     "object",
+	"required": []any{"data"},
+	"properties": map[string]any{
+		"data": map[string]any{
+			"type":                 "object",
+			"required":             []any{"username", "password"},
+			"additionalProperties": false,
+			"properties": map[string]any{
+				"username": map[string]any{"type": "string", "minLength": 3},
+				"password": map[string]any{
+					"type":      "string",
+					"minLength": 12,
+					"$md":       map[string]any{"sensitive": true},
+				},
+				"port": map[string]any{"type": "integer"},
+			},
+		},
+	},
+}
+
+func TestValidateAgainstSchemaReportsEveryViolation(t *testing.T) {
+	document := `{"data":{"username":"ab","password":"hunter2","port":"5432"}}`
+
+	diags := validateAgainstSchema("resource validation failed", credentialsSchema, document, cty.GetAttrPath("resource"))
+	if len(diags) != 3 {
+		t.Fatalf("got %d diagnostics, want 3: %v", len(diags), diags)
+	}
+
+	byPointer := map[string]string{}
+	for _, d := range diags {
+		if !d.AttributePath.Equals(cty.GetAttrPath("resource")) {
+			t.Errorf("%q: got attribute path %#v, want resource", d.Summary, d.AttributePath)
+		}
+		pointer := strings.TrimPrefix(d.Summary, "resource validation failed at ")
+		byPointer[pointer] = d.Detail
+	}
+
+	tests := map[string][]string{
+		"/data/username": {"Schema keyword: minLength", `Value: "ab"`},
+		"/data/password": {"Schema keyword: minLength", "Value: " + redactedValue},
+		"/data/port":     {"Schema keyword: type", `Value: "5432"`},
+	}
+	for pointer, want := range tests {
+		detail, ok := byPointer[pointer]
+		if !ok {
+			t.Errorf("no diagnostic for %s; got %v", pointer, byPointer)
+			continue
+		}
+		if !strings.Contains(detail, "JSON pointer: "+pointer) {
+			t.Errorf("%s: detail %q is missing the pointer", pointer, detail)
+		}
+		for _, w := range want {
+			if !strings.Contains(detail, w) {
+				t.Errorf("%s: detail %q does not contain %q", pointer, detail, w)
+			}
+		}
+	}
+	if strings.Contains(byPointer["/data/password"], "hunter2") {
+		t.Error("sensitive value leaked into the diagnostic")
+	}
+}
+
+func TestValidateAgainstSchemaPointsAtMissingAndExtraMembers(t *testing.T) {
+	document := `{"data":{"username":"admin","extra":true}}`
+
+	diags := validateAgainstSchema("resource validation failed", credentialsSchema, document, cty.GetAttrPath("resource"))
+
+	var summaries []string
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary)
+	}
+	got := strings.Join(summaries, "\n")
+	for _, want := range []string{
+		"resource validation failed at /data/password",
+		"resource validation failed at /data/extra",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestValidateAgainstSchemaValid(t *testing.T) {
+	document := `{"data":{"username":"admin","password":"correct-horse-battery"}}`
+
+	if diags := validateAgainstSchema("resource validation failed", credentialsSchema, document, cty.GetAttrPath("resource")); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestJSONPointerEscapes(t *testing.T) {
+	if got := jsonPointer([]string{"a/b", "c~d", "0"}); got != "/a~1b/c~0d/0" {
+		t.Errorf("got %q", got)
+	}
+	if got := jsonPointer(nil); got != "" {
+		t.Errorf("got %q for the root, want empty", got)
+	}
+}
+
+// Fields marked sensitive through a `$ref` (a local definition or a resource
+// type) are redacted like inline ones.
+func TestValidateBundleFieldRedactsThroughRefs(t *testing.T) {
+	dir := t.TempDir()
+	typesDir := filepath.Join(dir, "types")
+	if err := os.MkdirAll(filepath.Join(typesDir, "massdriver"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	typeSchema := `{"type":"object","properties":{"token":{"type":"string","minLength":20,"$md":{"sensitive":true}}}}`
+	if err := os.WriteFile(filepath.Join(typesDir, "massdriver", "api-token.json"), []byte(typeSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	schemaPath := filepath.Join(dir, "schema-artifacts.json")
+	doc := `{
+		"definitions": {"password": {"type": "string", "minLength": 12, "$md": {"sensitive": true}}},
+		"properties": {
+			"db": {
+				"type": "object",
+				"properties": {
+					"password": {"$ref": "#/definitions/password"},
+					"api": {"$ref": "massdriver/api-token"}
+				}
+			}
+		}
+	}`
+	if err := os.WriteFile(schemaPath, []byte(doc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	diags := validateBundleField("resource validation failed", "db", `{"password":"hunter2","api":{"token":"abc"}}`, schemaPath, bundle.DirResolver(typesDir), cty.GetAttrPath("resource"))
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2: %v", len(diags), diags)
+	}
+	for _, d := range diags {
+		if !strings.Contains(d.Detail, "Value: "+redactedValue) {
+			t.Errorf("%s: value should be redacted, got %q", d.Summary, d.Detail)
+		}
+		if strings.Contains(d.Detail, "hunter2") || strings.Contains(d.Detail, `"abc"`) {
+			t.Errorf("%s: sensitive value leaked: %q", d.Summary, d.Detail)
+		}
+	}
+}
@@ -1,6 +1,14 @@
 package massdriver
 
 import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 	"terraform-provider-massdriver/internal/gqlmock"
@@ -23,3 +31,302 @@ func newMockProvider(responses map[string]map[string]any) (*ProviderClient, *gql
 		},
 	}, rec
 }
+
+// newFrameworkServer serves the plugin framework half of the provider over
+// protocol 5, configured with pc, so tests drive resources through the same
+// calls Terraform makes.
+func newFrameworkServer(t *testing.T, pc *ProviderClient) tfprotov5.ProviderServer {
+	t.Helper()
+	server := providerserver.NewProtocol5(&frameworkProvider{meta: pc})()
+	schemas, err := server.GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := dynamicValue(t, schemas.Provider.ValueType(), "")
+	resp, err := server.ConfigureProvider(t.Context(), &tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	requireNoDiagnostics(t, resp.Diagnostics)
+	return server
+}
+
+// newSDKServer serves the SDKv2 half of the provider over protocol 5 with pc
+// as its meta. Tests go through it when they depend on what only Terraform
+// sends, such as the raw configuration that carries write-only attributes.
+func newSDKServer(pc *ProviderClient) tfprotov5.ProviderServer {
+	p := Provider()
+	p.SetMeta(pc)
+	return schema.NewGRPCProviderServer(p)
+}
+
+// resourceValueType is the state type Terraform stores for typeName.
+func resourceValueType(t *testing.T, server tfprotov5.ProviderServer, typeName string) tftypes.Type {
+	t.Helper()
+	return resourceSchema(t, server, typeName).ValueType()
+}
+
+func resourceSchema(t *testing.T, server tfprotov5.ProviderServer, typeName string) *tfprotov5.Schema {
+	t.Helper()
+	schemas, err := server.GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := schemas.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("server has no schema for %s", typeName)
+	}
+	return s
+}
+
+// dynamicValue encodes a JSON object, as found in a state file's
+// `attributes`, as a value of typ. Missing attributes are null; an empty
+// string is a null object.
+func dynamicValue(t *testing.T, typ tftypes.Type, attributes string) *tfprotov5.DynamicValue {
+	t.Helper()
+	v := tftypes.NewValue(typ, nil)
+	if attributes != "" {
+		var err error
+		raw := tfprotov5.RawState{JSON: []byte(attributes)}
+		if v, err = raw.Unmarshal(typ); err != nil {
+			t.Fatalf("decoding %s: %v", attributes, err)
+		}
+	}
+	dv, err := tfprotov5.NewDynamicValue(typ, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &dv
+}
+
+// stateJSON renders dv the way Terraform writes `attributes` in a state
+// file: object keys sorted, numbers in their shortest exact form.
+func stateJSON(t *testing.T, typ tftypes.Type, dv *tfprotov5.DynamicValue) string {
+	t.Helper()
+	v, err := dv.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(jsonValue(t, v))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func jsonValue(t *testing.T, v tftypes.Value) any {
+	t.Helper()
+	if v.IsNull() {
+		return nil
+	}
+	if !v.IsKnown() {
+		t.Fatalf("unknown value of type %s in state", v.Type())
+	}
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		_ = v.As(&f)
+		return json.Number(f.Text('f', -1))
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return b
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		_ = v.As(&elems)
+		out := make([]any, 0, len(elems))
+		for _, e := range elems {
+			out = append(out, jsonValue(t, e))
+		}
+		return out
+	default:
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			t.Fatalf("unsupported value of type %s: %v", typ, err)
+		}
+		out := make(map[string]any, len(attrs))
+		for k, e := range attrs {
+			out[k] = jsonValue(t, e)
+		}
+		return out
+	}
+}
+
+// applyResource plans and applies config against prior (both state-file
+// JSON; prior "" creates) the way Terraform core would, and returns the new
+// state as JSON along with every diagnostic raised on the way.
+func applyResource(t *testing.T, server tfprotov5.ProviderServer, typeName, prior, config string) (string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	typ := resourceValueType(t, server, typeName)
+	priorState := dynamicValue(t, typ, prior)
+	configValue := dynamicValue(t, typ, config)
+
+	plan := planResourceChange(t, server, typeName, prior, config)
+	if hasErrorDiagnostic(plan.Diagnostics) {
+		return "", plan.Diagnostics
+	}
+
+	applied, err := server.ApplyResourceChange(t.Context(), &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     priorState,
+		PlannedState:   plan.PlannedState,
+		Config:         configValue,
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := append(plan.Diagnostics, applied.Diagnostics...)
+	if applied.NewState == nil {
+		return "", diags
+	}
+	return stateJSON(t, typ, applied.NewState), diags
+}
+
+// planResource plans config against prior like applyResource, and returns
+// the planned state as JSON.
+func planResource(t *testing.T, server tfprotov5.ProviderServer, typeName, prior, config string) (string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	plan := planResourceChange(t, server, typeName, prior, config)
+	if hasErrorDiagnostic(plan.Diagnostics) {
+		return "", plan.Diagnostics
+	}
+	return stateJSON(t, resourceValueType(t, server, typeName), plan.PlannedState), plan.Diagnostics
+}
+
+func planResourceChange(t *testing.T, server tfprotov5.ProviderServer, typeName, prior, config string) *tfprotov5.PlanResourceChangeResponse {
+	t.Helper()
+	typ := resourceValueType(t, server, typeName)
+
+	// Core proposes the configuration, carrying computed attributes it
+	// leaves out over from the prior state.
+	proposed := map[string]json.RawMessage{}
+	if prior != "" {
+		var priorAttributes map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(prior), &priorAttributes); err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range resourceSchema(t, server, typeName).Block.Attributes {
+			if v, ok := priorAttributes[a.Name]; ok && a.Computed {
+				proposed[a.Name] = v
+			}
+		}
+	}
+	var configAttributes map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(config), &configAttributes); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range configAttributes {
+		proposed[k] = v
+	}
+	proposedJSON, err := json.Marshal(proposed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := server.PlanResourceChange(t.Context(), &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       dynamicValue(t, typ, prior),
+		ProposedNewState: dynamicValue(t, typ, string(proposedJSON)),
+		Config:           dynamicValue(t, typ, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plan
+}
+
+// readResource refreshes prior (state-file JSON) and returns the new state.
+func readResource(t *testing.T, server tfprotov5.ProviderServer, typeName, prior string) (string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	typ := resourceValueType(t, server, typeName)
+	resp, err := server.ReadResource(t.Context(), &tfprotov5.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: dynamicValue(t, typ, prior),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.NewState == nil {
+		return "", resp.Diagnostics
+	}
+	return stateJSON(t, typ, resp.NewState), resp.Diagnostics
+}
+
+// importResource imports id as Terraform does for `terraform import`, and
+// returns the imported state as JSON. Core reads the resource afterwards;
+// callers do that with readResource.
+func importResource(t *testing.T, server tfprotov5.ProviderServer, typeName, id string) (string, []*tfprotov5.Diagnostic) {
+	t.Helper()
+	resp, err := server.ImportResourceState(t.Context(), &tfprotov5.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasErrorDiagnostic(resp.Diagnostics) {
+		return "", resp.Diagnostics
+	}
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("got %d imported resources, want 1", len(resp.ImportedResources))
+	}
+	return stateJSON(t, resourceValueType(t, server, typeName), resp.ImportedResources[0].State), resp.Diagnostics
+}
+
+// callFunction calls a provider function with string arguments through the
+// muxed provider server, as Terraform evaluates `provider::massdriver::...`.
+func callFunction(t *testing.T, name string, args ...string) (tftypes.Value, *tfprotov5.FunctionError) {
+	t.Helper()
+	factory, err := ProviderServer(t.Context(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	arguments := make([]*tfprotov5.DynamicValue, 0, len(args))
+	for _, a := range args {
+		dv, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, a))
+		if err != nil {
+			t.Fatal(err)
+		}
+		arguments = append(arguments, &dv)
+	}
+	resp, err := factory().CallFunction(t.Context(), &tfprotov5.CallFunctionRequest{Name: name, Arguments: arguments})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	schemas, err := factory().GetFunctions(t.Context(), &tfprotov5.GetFunctionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := resp.Result.Unmarshal(schemas.Functions[name].Return.Type)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v, nil
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func requireNoDiagnostics(t *testing.T, diags []*tfprotov5.Diagnostic) {
+	t.Helper()
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if len(diags) > 0 {
+		t.FailNow()
+	}
+}